@@ -0,0 +1,282 @@
+// actorclient/client.go
+package actorclient
+
+import (
+    "fmt"
+    "time"
+    "github.com/asynkron/protoactor-go/actor"
+    "github.com/asynkron/protoactor-go/remote"
+    "reddit/proto"
+)
+
+// DefaultTimeout bounds how long a request waits for the engine to respond
+const DefaultTimeout = 5 * time.Second
+
+// engineName is the name the server spawns the engine under
+const engineName = "social"
+
+// Client talks to the social engine of a server over protoactor remote,
+// skipping the REST API. Requests are sent as-is, so they are not checked
+// against an auth token; callers act as whichever user a message names.
+//
+// Every method returns the engine's response unchanged. The error is only
+// set when the engine could not be reached or did not answer in time; a
+// request the engine refused comes back with Success false and a Message.
+type Client struct {
+    system  *actor.ActorSystem
+    remote  *remote.Remote
+    engine  *actor.PID
+    timeout time.Duration
+    host    string
+    port    int
+}
+
+type Option func(*Client)
+
+// WithTimeout sets how long requests wait for a response
+func WithTimeout(timeout time.Duration) Option {
+    return func(c *Client) {
+        c.timeout = timeout
+    }
+}
+
+// WithListenAddress sets the address the client receives responses on. The
+// server must be able to reach it. Port 0 picks a free port.
+func WithListenAddress(host string, port int) Option {
+    return func(c *Client) {
+        c.host = host
+        c.port = port
+    }
+}
+
+// Dial starts a local actor system and points it at the engine of the server
+// whose actor port is at address, such as "127.0.0.1:8085". Any member of a
+// cluster will do.
+func Dial(address string, options ...Option) *Client {
+    c := &Client{
+        system:  actor.NewActorSystem(),
+        engine:  actor.NewPID(address, engineName),
+        timeout: DefaultTimeout,
+        host:    "127.0.0.1",
+    }
+    for _, option := range options {
+        option(c)
+    }
+
+    c.remote = remote.NewRemote(c.system, remote.Configure(c.host, c.port))
+    c.remote.Start()
+    return c
+}
+
+// Close stops the client's actor system
+func (c *Client) Close() {
+    c.remote.Shutdown(true)
+    c.system.Shutdown()
+}
+
+// request sends msg to the engine and waits for a response of type T
+func request[T any](c *Client, msg interface{}) (T, error) {
+    var zero T
+    result, err := c.system.Root.RequestFuture(c.engine, msg, c.timeout).Result()
+    if err != nil {
+        return zero, fmt.Errorf("%T failed: %v", msg, err)
+    }
+    if unavailable, ok := result.(*proto.EngineUnavailable); ok {
+        return zero, fmt.Errorf("%T failed: %s", msg, unavailable.Message)
+    }
+    response, ok := result.(T)
+    if !ok {
+        return zero, fmt.Errorf("unexpected response %T to %T", result, msg)
+    }
+    return response, nil
+}
+
+// Users
+
+func (c *Client) OnboardUser(msg *proto.OnboardUser) (*proto.OnboardUserResponse, error) {
+    return request[*proto.OnboardUserResponse](c, msg)
+}
+
+func (c *Client) CheckPassword(msg *proto.CheckPassword) (*proto.PasswordCheck, error) {
+    return request[*proto.PasswordCheck](c, msg)
+}
+
+func (c *Client) UpdateActivity(msg *proto.ActivityStatus) (*proto.ActivityStatusResponse, error) {
+    return request[*proto.ActivityStatusResponse](c, msg)
+}
+
+func (c *Client) GetUserProfile(msg *proto.GetUserProfile) (*proto.UserProfile, error) {
+    return request[*proto.UserProfile](c, msg)
+}
+
+// Forums
+
+func (c *Client) CreateForum(msg *proto.CreateForum) (*proto.CreateForumResponse, error) {
+    return request[*proto.CreateForumResponse](c, msg)
+}
+
+func (c *Client) JoinForum(msg *proto.JoinForum) (*proto.JoinForumResponse, error) {
+    return request[*proto.JoinForumResponse](c, msg)
+}
+
+func (c *Client) LeaveForum(msg *proto.LeaveForum) (*proto.LeaveForumResponse, error) {
+    return request[*proto.LeaveForumResponse](c, msg)
+}
+
+func (c *Client) GetForumDetails(msg *proto.GetForumDetails) (*proto.ForumDetails, error) {
+    return request[*proto.ForumDetails](c, msg)
+}
+
+func (c *Client) UpdateForumSettings(msg *proto.UpdateForumSettings) (*proto.ModerationResponse, error) {
+    return request[*proto.ModerationResponse](c, msg)
+}
+
+func (c *Client) InviteToForum(msg *proto.InviteToForum) (*proto.ModerationResponse, error) {
+    return request[*proto.ModerationResponse](c, msg)
+}
+
+func (c *Client) RequestToJoin(msg *proto.RequestToJoin) (*proto.JoinForumResponse, error) {
+    return request[*proto.JoinForumResponse](c, msg)
+}
+
+func (c *Client) ReviewJoinRequest(msg *proto.ReviewJoinRequest) (*proto.ModerationResponse, error) {
+    return request[*proto.ModerationResponse](c, msg)
+}
+
+func (c *Client) GetJoinRequests(msg *proto.GetJoinRequests) (*proto.JoinRequestList, error) {
+    return request[*proto.JoinRequestList](c, msg)
+}
+
+// Vote manipulation
+
+func (c *Client) GetFlaggedVoters(msg *proto.GetFlaggedVoters) (*proto.FlaggedVoterList, error) {
+    return request[*proto.FlaggedVoterList](c, msg)
+}
+
+func (c *Client) ClearVoteFlag(msg *proto.ClearVoteFlag) (*proto.ModerationResponse, error) {
+    return request[*proto.ModerationResponse](c, msg)
+}
+
+// Posts and comments
+
+func (c *Client) CreateContent(msg *proto.CreateContent) (*proto.CreateContentResponse, error) {
+    return request[*proto.CreateContentResponse](c, msg)
+}
+
+func (c *Client) GetPost(msg *proto.GetPost) (*proto.GetPostResponse, error) {
+    return request[*proto.GetPostResponse](c, msg)
+}
+
+func (c *Client) GetCrossposts(msg *proto.GetCrossposts) (*proto.CrosspostList, error) {
+    return request[*proto.CrosspostList](c, msg)
+}
+
+func (c *Client) GetMedia(msg *proto.GetMedia) (*proto.MediaAccess, error) {
+    return request[*proto.MediaAccess](c, msg)
+}
+
+func (c *Client) EditContent(msg *proto.EditContent) (*proto.EditResponse, error) {
+    return request[*proto.EditResponse](c, msg)
+}
+
+func (c *Client) DeleteContent(msg *proto.DeleteContent) (*proto.EditResponse, error) {
+    return request[*proto.EditResponse](c, msg)
+}
+
+func (c *Client) CreateFeedback(msg *proto.CreateFeedback) (*proto.CreateFeedbackResponse, error) {
+    return request[*proto.CreateFeedbackResponse](c, msg)
+}
+
+func (c *Client) GetFeedback(msg *proto.GetFeedback) (*proto.GetFeedbackResponse, error) {
+    return request[*proto.GetFeedbackResponse](c, msg)
+}
+
+func (c *Client) GetCommentTree(msg *proto.GetCommentTree) (*proto.CommentTree, error) {
+    return request[*proto.CommentTree](c, msg)
+}
+
+func (c *Client) EditFeedback(msg *proto.EditFeedback) (*proto.EditResponse, error) {
+    return request[*proto.EditResponse](c, msg)
+}
+
+func (c *Client) DeleteFeedback(msg *proto.DeleteFeedback) (*proto.EditResponse, error) {
+    return request[*proto.EditResponse](c, msg)
+}
+
+func (c *Client) React(msg *proto.Reaction) (*proto.ReactionResponse, error) {
+    return request[*proto.ReactionResponse](c, msg)
+}
+
+func (c *Client) GetFeed(msg *proto.GetFeed) (*proto.FeedBundle, error) {
+    return request[*proto.FeedBundle](c, msg)
+}
+
+func (c *Client) GetFrontPage(msg *proto.GetFrontPage) (*proto.FeedBundle, error) {
+    return request[*proto.FeedBundle](c, msg)
+}
+
+func (c *Client) Search(msg *proto.Search) (*proto.SearchResults, error) {
+    return request[*proto.SearchResults](c, msg)
+}
+
+// Moderation
+
+func (c *Client) AppointModerator(msg *proto.AppointModerator) (*proto.ModerationResponse, error) {
+    return request[*proto.ModerationResponse](c, msg)
+}
+
+func (c *Client) RemoveModerator(msg *proto.RemoveModerator) (*proto.ModerationResponse, error) {
+    return request[*proto.ModerationResponse](c, msg)
+}
+
+func (c *Client) BanUser(msg *proto.BanUser) (*proto.ModerationResponse, error) {
+    return request[*proto.ModerationResponse](c, msg)
+}
+
+func (c *Client) UnbanUser(msg *proto.UnbanUser) (*proto.ModerationResponse, error) {
+    return request[*proto.ModerationResponse](c, msg)
+}
+
+func (c *Client) RemoveContent(msg *proto.RemoveContent) (*proto.ModerationResponse, error) {
+    return request[*proto.ModerationResponse](c, msg)
+}
+
+func (c *Client) RemoveFeedback(msg *proto.RemoveFeedback) (*proto.ModerationResponse, error) {
+    return request[*proto.ModerationResponse](c, msg)
+}
+
+func (c *Client) LockContent(msg *proto.LockContent) (*proto.ModerationResponse, error) {
+    return request[*proto.ModerationResponse](c, msg)
+}
+
+// Messages
+
+func (c *Client) SendMessage(msg *proto.DirectChat) (*proto.ChatResponse, error) {
+    return request[*proto.ChatResponse](c, msg)
+}
+
+func (c *Client) GetChats(msg *proto.GetChats) (*proto.ChatBundle, error) {
+    return request[*proto.ChatBundle](c, msg)
+}
+
+func (c *Client) GetConversations(msg *proto.GetConversations) (*proto.ConversationList, error) {
+    return request[*proto.ConversationList](c, msg)
+}
+
+func (c *Client) GetConversation(msg *proto.GetConversation) (*proto.ChatBundle, error) {
+    return request[*proto.ChatBundle](c, msg)
+}
+
+func (c *Client) MarkRead(msg *proto.MarkRead) (*proto.MarkReadResponse, error) {
+    return request[*proto.MarkReadResponse](c, msg)
+}
+
+// Notifications
+
+func (c *Client) GetNotifications(msg *proto.GetNotifications) (*proto.NotificationList, error) {
+    return request[*proto.NotificationList](c, msg)
+}
+
+func (c *Client) MarkNotificationsRead(msg *proto.MarkNotificationsRead) (*proto.MarkReadResponse, error) {
+    return request[*proto.MarkReadResponse](c, msg)
+}
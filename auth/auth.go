@@ -0,0 +1,132 @@
+// auth/auth.go
+package auth
+
+import (
+    "crypto/hmac"
+    "crypto/rand"
+    "crypto/sha256"
+    "encoding/base64"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "os"
+    "path/filepath"
+    "strings"
+    "time"
+    "golang.org/x/crypto/bcrypt"
+)
+
+var (
+    ErrInvalidToken = errors.New("invalid token")
+    ErrExpiredToken = errors.New("token expired")
+)
+
+// HashPassword hashes a password for storage
+func HashPassword(password string) (string, error) {
+    hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+    if err != nil {
+        return "", fmt.Errorf("failed to hash password: %v", err)
+    }
+    return string(hash), nil
+}
+
+// CheckPassword reports whether password matches a hash from HashPassword
+func CheckPassword(hash, password string) bool {
+    if hash == "" {
+        return false
+    }
+    return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+type claims struct {
+    Subject   string `json:"sub"`
+    ExpiresAt int64  `json:"exp"`
+}
+
+// TokenIssuer signs and verifies bearer tokens with an HMAC key
+type TokenIssuer struct {
+    key []byte
+    ttl time.Duration
+}
+
+func NewTokenIssuer(key []byte, ttl time.Duration) *TokenIssuer {
+    return &TokenIssuer{
+        key: key,
+        ttl: ttl,
+    }
+}
+
+// Issue returns a signed token for username and the time it expires
+func (t *TokenIssuer) Issue(username string) (string, time.Time, error) {
+    expiresAt := time.Now().Add(t.ttl)
+    payload, err := json.Marshal(claims{
+        Subject:   username,
+        ExpiresAt: expiresAt.Unix(),
+    })
+    if err != nil {
+        return "", time.Time{}, fmt.Errorf("failed to encode token: %v", err)
+    }
+
+    encoded := base64.RawURLEncoding.EncodeToString(payload)
+    return encoded + "." + t.sign(encoded), expiresAt, nil
+}
+
+// Verify checks the signature and expiry of a token and returns its username
+func (t *TokenIssuer) Verify(token string) (string, error) {
+    parts := strings.Split(token, ".")
+    if len(parts) != 2 {
+        return "", ErrInvalidToken
+    }
+    if !hmac.Equal([]byte(parts[1]), []byte(t.sign(parts[0]))) {
+        return "", ErrInvalidToken
+    }
+
+    payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+    if err != nil {
+        return "", ErrInvalidToken
+    }
+    var c claims
+    if err := json.Unmarshal(payload, &c); err != nil || c.Subject == "" {
+        return "", ErrInvalidToken
+    }
+    if time.Now().Unix() >= c.ExpiresAt {
+        return "", ErrExpiredToken
+    }
+    return c.Subject, nil
+}
+
+func (t *TokenIssuer) sign(payload string) string {
+    mac := hmac.New(sha256.New, t.key)
+    mac.Write([]byte(payload))
+    return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// LoadOrCreateKey reads a signing key from path, generating and saving a new
+// random key the first time so tokens stay valid across restarts
+func LoadOrCreateKey(path string) ([]byte, error) {
+    key, err := os.ReadFile(path)
+    if err == nil && len(key) > 0 {
+        return key, nil
+    }
+    if err != nil && !errors.Is(err, os.ErrNotExist) {
+        return nil, fmt.Errorf("failed to read signing key: %v", err)
+    }
+
+    key = NewKey()
+    if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+        return nil, fmt.Errorf("failed to create key directory: %v", err)
+    }
+    if err := os.WriteFile(path, key, 0600); err != nil {
+        return nil, fmt.Errorf("failed to write signing key: %v", err)
+    }
+    return key, nil
+}
+
+// NewKey returns a random signing key
+func NewKey() []byte {
+    key := make([]byte, 32)
+    if _, err := rand.Read(key); err != nil {
+        panic(fmt.Sprintf("failed to generate signing key: %v", err))
+    }
+    return key
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 	"time"
@@ -16,6 +17,8 @@ import (
 type RestClient struct {
 	baseURL    string
 	httpClient *http.Client
+	username   string
+	token      string
 }
 
 type Response struct {
@@ -33,24 +36,45 @@ func NewRestClient(baseURL string) *RestClient {
 	}
 }
 
-func (c *RestClient) RegisterUser(username string) error {
-	payload := map[string]string{"username": username}
+func (c *RestClient) RegisterUser(username, password string) error {
+	payload := map[string]string{"username": username, "password": password}
 	return c.post("/api/users", payload)
 }
 
+// Login obtains a token that is sent with every following request
+func (c *RestClient) Login(username, password string) error {
+	payload := map[string]string{"username": username, "password": password}
+	var response Response
+	if err := c.doRequest("POST", "/api/login", payload, &response); err != nil {
+		return err
+	}
+	if !response.Success {
+		return fmt.Errorf(response.Message)
+	}
+	data, ok := response.Data.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected response format")
+	}
+	token, ok := data["token"].(string)
+	if !ok {
+		return fmt.Errorf("token not found in response")
+	}
+	c.username = username
+	c.token = token
+	return nil
+}
+
 func (c *RestClient) CreateForum(name, description string) error {
 	payload := map[string]string{"name": name, "description": description}
 	return c.post("/api/forums", payload)
 }
 
-func (c *RestClient) JoinForum(username, forumName string) error {
-	payload := map[string]string{"username": username}
-	return c.post(fmt.Sprintf("/api/forums/%s/join", forumName), payload)
+func (c *RestClient) JoinForum(forumName string) error {
+	return c.post(fmt.Sprintf("/api/forums/%s/join", forumName), nil)
 }
 
-func (c *RestClient) CreatePost(username, forum, title, content string, isRepost bool, originalId string) (string, error) {
+func (c *RestClient) CreatePost(forum, title, content string, isRepost bool, originalId string) (string, error) {
     payload := map[string]interface{}{
-        "username":   username,
         "subreddit":  forum,
         "title":      title,
         "content":    content,
@@ -76,44 +100,45 @@ func (c *RestClient) CreatePost(username, forum, title, content string, isRepost
     return contentId, nil
 }
 
-func (c *RestClient) CreateComment(username, postId, parentId, content string) error {
+func (c *RestClient) CreateComment(postId, parentId, content string) error {
 	payload := map[string]interface{}{
-		"username": username,
 		"content":  content,
 		"parentId": parentId,
 	}
 	return c.post(fmt.Sprintf("/api/posts/%s/comments", postId), payload)
 }
 
-func (c *RestClient) Vote(username, postId string, isUpvote bool) error {
-	payload := map[string]interface{}{"username": username, "isUpvote": isUpvote}
+func (c *RestClient) Vote(postId string, isUpvote bool) error {
+	payload := map[string]interface{}{"isUpvote": isUpvote}
 	return c.post(fmt.Sprintf("/api/posts/%s/vote", postId), payload)
 }
 
-func (c *RestClient) SendMessage(from, to, content string) error {
+func (c *RestClient) SendMessage(to, content string) error {
 	payload := map[string]interface{}{
-		"senderUsername":   from,
 		"receiverUsername": to,
 		"content":          content,
 	}
 	return c.post("/api/messages", payload)
 }
 
-func (c *RestClient) GetMessages(username string) ([]interface{}, error) {
+func (c *RestClient) GetMessages() ([]interface{}, error) {
 	var response Response
-	err := c.doRequest("GET", fmt.Sprintf("/api/messages/%s", username), nil, &response)
+	err := c.doRequest("GET", fmt.Sprintf("/api/messages/%s", c.username), nil, &response)
 	if err != nil {
 		return nil, err
 	}
+	if !response.Success {
+		return nil, fmt.Errorf(response.Message)
+	}
 	if messages, ok := response.Data.([]interface{}); ok {
 		return messages, nil
 }
 return nil, fmt.Errorf("invalid messages format in response")
 }
 
-func (c *RestClient) GetFeed(username, sortMethod string) ([]*proto.Content, error) {
+func (c *RestClient) GetFeed(sortMethod string) ([]*proto.Content, error) {
     var response Response
-    err := c.doRequest("GET", fmt.Sprintf("/api/feed?username=%s&sort=%s", username, sortMethod), nil, &response)
+    err := c.doRequest("GET", fmt.Sprintf("/api/feed?sort=%s", sortMethod), nil, &response)
     if err != nil {
         return nil, err
     }
@@ -144,9 +169,48 @@ func (c *RestClient) GetFeed(username, sortMethod string) ([]*proto.Content, err
     return feed, nil
 }
 
+// Search runs a full-text query; filters holds optional type, forum, author,
+// sort and t parameters
+func (c *RestClient) Search(query string, filters map[string]string) ([]*proto.SearchResult, error) {
+    params := url.Values{}
+    params.Set("q", query)
+    for key, value := range filters {
+        params.Set(key, value)
+    }
+
+    var response Response
+    if err := c.doRequest("GET", "/api/search?"+params.Encode(), nil, &response); err != nil {
+        return nil, err
+    }
+    if !response.Success {
+        return nil, fmt.Errorf(response.Message)
+    }
+
+    items, ok := response.Data.([]interface{})
+    if !ok {
+        return nil, fmt.Errorf("unexpected response format")
+    }
+
+    var results []*proto.SearchResult
+    for _, item := range items {
+        result := &proto.SearchResult{}
+        if err := mapstructure.Decode(item, result); err != nil {
+            return nil, fmt.Errorf("failed to decode search result: %v", err)
+        }
+        results = append(results, result)
+    }
+    return results, nil
+}
+
 func (c *RestClient) post(endpoint string, payload interface{}) error {
 	var response Response
-	return c.doRequest("POST", endpoint, payload, &response)
+	if err := c.doRequest("POST", endpoint, payload, &response); err != nil {
+		return err
+	}
+	if !response.Success {
+		return fmt.Errorf(response.Message)
+	}
+	return nil
 }
 
 func (c *RestClient) doRequest(method, endpoint string, payload interface{}, response interface{}) error {
@@ -165,6 +229,9 @@ func (c *RestClient) doRequest(method, endpoint string, payload interface{}, res
 	if err != nil {
 		return fmt.Errorf("failed to create request: %v", err)
 	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("request failed: %v", err)
@@ -179,15 +246,17 @@ func main() {
 
 	fmt.Println("Interactive Reddit Client")
 	fmt.Println("Available Commands:")
-	fmt.Println("  register <username>")
+	fmt.Println("  register <username> <password>")
+	fmt.Println("  login <username> <password>")
 	fmt.Println("  create_forum <forum_name> <description>")
-	fmt.Println("  join_forum <username> <forum_name>")
-	fmt.Println("  create_post <username> <forum> <title> <content> [isRepost] [originalId]")
-	fmt.Println("  comment <username> <postId> <parentId> <content>")
-	fmt.Println("  vote <username> <postId> <upvote/downvote>")
-	fmt.Println("  send_message <from> <to> <content>")
-	fmt.Println("  get_messages <username>")
-	fmt.Println("  get_feed <username> <sortMethod>")
+	fmt.Println("  join_forum <forum_name>")
+	fmt.Println("  create_post <forum> <title> <content>")
+	fmt.Println("  comment <postId> <parentId> <content>")
+	fmt.Println("  vote <postId> <upvote/downvote>")
+	fmt.Println("  send_message <to> <content>")
+	fmt.Println("  get_messages")
+	fmt.Println("  get_feed <sortMethod>")
+	fmt.Println("  search [type=|forum=|author=|sort=|t=<value>] <query>")
 	fmt.Println("  exit")
 
 	for {
@@ -198,16 +267,27 @@ func main() {
 
 		switch args[0] {
 		case "register":
-			if len(args) != 2 {
-				fmt.Println("Usage: register <username>")
+			if len(args) != 3 {
+				fmt.Println("Usage: register <username> <password>")
 				continue
 			}
-			err := client.RegisterUser(args[1])
+			err := client.RegisterUser(args[1], args[2])
 			if err != nil {
 				fmt.Println("Error:", err)
 			} else {
 				fmt.Println("User registered successfully.")
 			}
+		case "login":
+			if len(args) != 3 {
+				fmt.Println("Usage: login <username> <password>")
+				continue
+			}
+			err := client.Login(args[1], args[2])
+			if err != nil {
+				fmt.Println("Error:", err)
+			} else {
+				fmt.Println("Logged in successfully.")
+			}
 		case "create_forum":
 			if len(args) < 3 {
 				fmt.Println("Usage: create_forum <forum_name> <description>")
@@ -220,84 +300,83 @@ func main() {
 				fmt.Println("Forum created successfully.")
 			}
 		case "join_forum":
-			if len(args) != 3 {
-				fmt.Println("Usage: join_forum <username> <forum_name>")
+			if len(args) != 2 {
+				fmt.Println("Usage: join_forum <forum_name>")
 				continue
 			}
-			err := client.JoinForum(args[1], args[2])
+			err := client.JoinForum(args[1])
 			if err != nil {
 				fmt.Println("Error:", err)
 			} else {
 				fmt.Println("Joined forum successfully.")
 			}
 		case "create_post":
-            if len(args) < 5 {
-                fmt.Println("Usage: create_post <username> <forum> <title> <content>")
+            if len(args) < 4 {
+                fmt.Println("Usage: create_post <forum> <title> <content>")
                 continue
             }
-            username := args[1]
-            forum := args[2]
-            title := args[3]
-            content := strings.Join(args[4:], " ")
+            forum := args[1]
+            title := args[2]
+            content := strings.Join(args[3:], " ")
             isRepost := false
             originalId := ""
-            contentId, err := client.CreatePost(username, forum, title, content, isRepost, originalId)
+            contentId, err := client.CreatePost(forum, title, content, isRepost, originalId)
             if err != nil {
                 fmt.Println("Error:", err)
             } else {
                 fmt.Printf("Post created successfully. Content ID: %s\n", contentId)
             }
 		case "comment":
-			if len(args) < 5 {
-				fmt.Println("Usage: comment <username> <postId> <parentId> <content>")
+			if len(args) < 4 {
+				fmt.Println("Usage: comment <postId> <parentId> <content>")
 				continue
 			}
-			err := client.CreateComment(args[1], args[2], args[3], args[4])
+			err := client.CreateComment(args[1], args[2], strings.Join(args[3:], " "))
 			if err != nil {
 				fmt.Println("Error:", err)
 			} else {
 				fmt.Println("Comment added successfully.")
 			}
 		case "vote":
-			if len(args) != 4 {
-				fmt.Println("Usage: vote <username> <postId> <upvote/downvote>")
+			if len(args) != 3 {
+				fmt.Println("Usage: vote <postId> <upvote/downvote>")
 				continue
 			}
-			isUpvote := args[3] == "upvote"
-			err := client.Vote(args[1], args[2], isUpvote)
+			isUpvote := args[2] == "upvote"
+			err := client.Vote(args[1], isUpvote)
 			if err != nil {
 				fmt.Println("Error:", err)
 			} else {
 				fmt.Println("Vote recorded successfully.")
 			}
 		case "send_message":
-			if len(args) < 4 {
-				fmt.Println("Usage: send_message <from> <to> <content>")
+			if len(args) < 3 {
+				fmt.Println("Usage: send_message <to> <content>")
 				continue
 			}
-			err := client.SendMessage(args[1], args[2], strings.Join(args[3:], " "))
+			err := client.SendMessage(args[1], strings.Join(args[2:], " "))
 			if err != nil {
 				fmt.Println("Error:", err)
 			} else {
 				fmt.Println("Message sent successfully.")
 			}
 		case "get_messages":
-			if len(args) != 2 {
-				fmt.Println("Usage: get_messages <username>")
+			if len(args) != 1 {
+				fmt.Println("Usage: get_messages")
 				continue
 			}
-			messages, err := client.GetMessages(args[1])
+			messages, err := client.GetMessages()
 			if err != nil {
 				fmt.Println("Error:", err)
 			} else {
 				fmt.Printf("Messages: %v\n", messages)
 			}
 		case "get_feed":
-            if len(args) != 3 {
-                fmt.Println("Usage: get_feed <username> <sortMethod>")
+            if len(args) != 2 {
+                fmt.Println("Usage: get_feed <sortMethod>")
                 continue
             }
-            feed, err := client.GetFeed(args[1], args[2])
+            feed, err := client.GetFeed(args[1])
             if err != nil {
                 fmt.Println("Error:", err)
             } else {
@@ -310,11 +389,55 @@ func main() {
                     fmt.Printf("  Body: %s\n", post.Body)
                     fmt.Printf("  Points: %d\n", post.Points)
                     fmt.Printf("  Is Share: %v\n", post.IsShare)
+                    if post.Original != nil {
+                        if post.Original.Unavailable {
+                            fmt.Println("  Crossposted from a post that is no longer available")
+                        } else {
+                            fmt.Printf("  Crossposted from r/%s by u/%s\n", post.Original.Subreddit, post.Original.Creator)
+                        }
+                    }
+                    if post.CrosspostCount > 0 {
+                        fmt.Printf("  Crossposts: %d\n", post.CrosspostCount)
+                    }
                     fmt.Printf("  Feedback Count: %d\n", len(post.Feedback))
                     fmt.Printf("  Reactions: %v\n", post.Reactions)
                     fmt.Println("---")
                 }
             }
+		case "search":
+			filters := make(map[string]string)
+			var terms []string
+			for _, arg := range args[1:] {
+				key, value, found := strings.Cut(arg, "=")
+				if found && (key == "type" || key == "forum" || key == "author" || key == "sort" || key == "t") {
+					filters[key] = value
+				} else {
+					terms = append(terms, arg)
+				}
+			}
+			if len(terms) == 0 {
+				fmt.Println("Usage: search [type=|forum=|author=|sort=|t=<value>] <query>")
+				continue
+			}
+			results, err := client.Search(strings.Join(terms, " "), filters)
+			if err != nil {
+				fmt.Println("Error:", err)
+			} else {
+				fmt.Printf("Found %d results:\n", len(results))
+				for i, result := range results {
+					fmt.Printf("#%d [%s] %s\n", i+1, result.Kind, result.Id)
+					if result.Title != "" {
+						fmt.Printf("  Title: %s\n", result.Title)
+					}
+					if result.Snippet != "" {
+						fmt.Printf("  %s\n", result.Snippet)
+					}
+					if result.Forum != "" {
+						fmt.Printf("  Forum: %s\n", result.Forum)
+					}
+					fmt.Printf("  Author: %s, Points: %d\n", result.Author, result.Points)
+				}
+			}
 		case "exit":
 			fmt.Println("Exiting...")
 			return
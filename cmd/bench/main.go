@@ -0,0 +1,186 @@
+package main
+
+import (
+    "flag"
+    "fmt"
+    "io"
+    "log"
+    "math/rand"
+    "os"
+    "sort"
+    "sync"
+    "time"
+    "github.com/asynkron/protoactor-go/actor"
+    "reddit/engine"
+    "reddit/proto"
+    "reddit/storage"
+)
+
+// Measures how throughput scales with the number of forums being used. Each
+// forum is served by its own shard, so spreading the same load over more
+// forums should let more queries and commands run in parallel. The load mixes
+// reads with writes: new posts, votes and comments.
+
+func request(system *actor.ActorSystem, pid *actor.PID, msg interface{}) interface{} {
+    result, err := system.Root.RequestFuture(pid, msg, 5*time.Second).Result()
+    if err != nil {
+        fmt.Fprintf(os.Stderr, "Request %T failed: %v\n", msg, err)
+        os.Exit(1)
+    }
+    return result
+}
+
+// seed creates a user, the given number of forums and some posts in each,
+// returning the post IDs per forum
+func seed(system *actor.ActorSystem, pid *actor.PID, forums int, posts int) map[string][]string {
+    request(system, pid, &proto.OnboardUser{UserHandle: "bench", PasswordHash: "bench"})
+
+    contents := make(map[string][]string)
+    for f := 0; f < forums; f++ {
+        name := fmt.Sprintf("bench%d", f)
+        request(system, pid, &proto.CreateForum{Name: name, UserHandle: "bench"})
+        for p := 0; p < posts; p++ {
+            response := request(system, pid, &proto.CreateContent{
+                UserHandle: "bench",
+                Subreddit:  name,
+                Heading:    fmt.Sprintf("Post %d", p),
+                Body:       "Benchmark post body",
+            }).(*proto.CreateContentResponse)
+            contents[name] = append(contents[name], response.ContentId)
+        }
+    }
+    return contents
+}
+
+// stats collects the latencies of one kind of request
+type stats struct {
+    latencies []time.Duration
+}
+
+func (s *stats) add(latency time.Duration) {
+    s.latencies = append(s.latencies, latency)
+}
+
+func (s *stats) merge(other *stats) {
+    s.latencies = append(s.latencies, other.latencies...)
+}
+
+// percentile returns the latency below which the given fraction of requests
+// completed
+func (s *stats) percentile(fraction float64) time.Duration {
+    if len(s.latencies) == 0 {
+        return 0
+    }
+    sorted := append([]time.Duration(nil), s.latencies...)
+    sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+    return sorted[int(fraction*float64(len(sorted)-1))]
+}
+
+// write sends one of the write requests, cycling through new posts, votes
+// and comments. Votes are cast by the posts' author, so that the vote
+// integrity checks never quarantine the benchmark's account.
+func write(system *actor.ActorSystem, pid *actor.PID, name string, posts []string, i int) {
+    post := posts[i%len(posts)]
+    switch i % 3 {
+    case 0:
+        request(system, pid, &proto.CreateContent{
+            UserHandle: "bench",
+            Subreddit:  name,
+            Heading:    fmt.Sprintf("Post %d", i),
+            Body:       "Benchmark post body",
+        })
+    case 1:
+        request(system, pid, &proto.Reaction{
+            UserHandle: "bench",
+            ItemId:     post,
+            IsContent:  true,
+            IsPositive: i%2 == 0,
+        })
+    case 2:
+        request(system, pid, &proto.CreateFeedback{
+            UserHandle: "bench",
+            ContentId:  post,
+            Body:       "Benchmark comment",
+        })
+    }
+}
+
+// run sends requests from the given number of workers for the duration,
+// spreading them evenly over the forums. The given fraction of them are
+// writes. It returns the latencies of reads and writes.
+func run(system *actor.ActorSystem, pid *actor.PID, contents map[string][]string, workers int, writes float64, duration time.Duration) (*stats, *stats) {
+    names := make([]string, 0, len(contents))
+    for name := range contents {
+        names = append(names, name)
+    }
+    sort.Strings(names)
+
+    reads, written := &stats{}, &stats{}
+    var mutex sync.Mutex
+    deadline := time.Now().Add(duration)
+    var wg sync.WaitGroup
+    for w := 0; w < workers; w++ {
+        wg.Add(1)
+        go func(worker int) {
+            defer wg.Done()
+            random := rand.New(rand.NewSource(int64(worker)))
+            workerReads, workerWrites := &stats{}, &stats{}
+            for i := worker; time.Now().Before(deadline); i++ {
+                name := names[i%len(names)]
+                posts := contents[name]
+                start := time.Now()
+                if random.Float64() < writes {
+                    write(system, pid, name, posts, i)
+                    workerWrites.add(time.Since(start))
+                    continue
+                }
+                if i%2 == 0 {
+                    request(system, pid, &proto.GetForumDetails{ForumName: name, SortMethod: "hot"})
+                } else {
+                    request(system, pid, &proto.GetPost{ContentId: posts[i%len(posts)]})
+                }
+                workerReads.add(time.Since(start))
+            }
+
+            mutex.Lock()
+            defer mutex.Unlock()
+            reads.merge(workerReads)
+            written.merge(workerWrites)
+        }(w)
+    }
+    wg.Wait()
+    return reads, written
+}
+
+func main() {
+    maxForums := flag.Int("forums", 8, "Largest number of forums to spread the load over")
+    posts := flag.Int("posts", 200, "Posts per forum")
+    workers := flag.Int("workers", 32, "Concurrent clients")
+    writes := flag.Float64("writes", 0.2, "Fraction of requests that are writes")
+    duration := flag.Duration("duration", 5*time.Second, "Length of each run")
+    flag.Parse()
+
+    // The engine logs every request, which would dominate the measurement
+    log.SetOutput(io.Discard)
+
+    fmt.Printf("%-8s %-12s %-10s %-10s %-12s %-10s %s\n",
+        "forums", "reads/sec", "read p50", "read p99", "writes/sec", "write p50", "write p99")
+    for forums := 1; forums <= *maxForums; forums *= 2 {
+        system := actor.NewActorSystem()
+        social := engine.NewSocialEngine(storage.NewNopStore(), storage.NewNopJournal())
+        pid, err := system.Root.SpawnNamed(social.ShardProps(), "social")
+        if err != nil {
+            fmt.Fprintf(os.Stderr, "Failed to start engine: %v\n", err)
+            os.Exit(1)
+        }
+
+        contents := seed(system, pid, forums, *posts)
+        reads, written := run(system, pid, contents, *workers, *writes, *duration)
+        fmt.Printf("%-8d %-12.0f %-10v %-10v %-12.0f %-10v %v\n", forums,
+            float64(len(reads.latencies))/duration.Seconds(), reads.percentile(0.5), reads.percentile(0.99),
+            float64(len(written.latencies))/duration.Seconds(), written.percentile(0.5), written.percentile(0.99))
+
+        system.Root.Stop(pid)
+        system.Shutdown()
+    }
+}
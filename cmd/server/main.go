@@ -6,10 +6,21 @@ import (
     "io"
     "log"
     "os"
+    "os/signal"
+    "path/filepath"
+    "strings"
+    "syscall"
+    "time"
     "github.com/asynkron/protoactor-go/actor"
+    "github.com/asynkron/protoactor-go/cluster"
+    "github.com/asynkron/protoactor-go/cluster/identitylookup/disthash"
     "github.com/asynkron/protoactor-go/remote"
+    "reddit/auth"
+    "reddit/discovery"
     "reddit/engine"
     "reddit/rest"
+    "reddit/rpc"
+    "reddit/storage"
 )
 
 func setupLogging() (*os.File, error) {
@@ -34,12 +45,102 @@ func setupLogging() (*os.File, error) {
     return f, nil
 }
 
+// replayJournal rebuilds the engine state from a journal. The store and
+// journal must be empty, so that a replay never overwrites existing data.
+func replayJournal(social *engine.SocialEngine, store storage.Store, journal storage.Journal, path string) error {
+    snapshot, records, err := store.Load()
+    if err != nil {
+        return err
+    }
+    if snapshot != nil || len(records) > 0 || journal.LastSequence() > 0 {
+        return fmt.Errorf("the data directory already holds state; replay into an empty -data-dir")
+    }
+
+    entries, err := storage.ReadJournal(path)
+    if err != nil {
+        return err
+    }
+
+    log.Printf("Replaying %d journal entries from %s", len(entries), path)
+    if err := social.Replay(entries); err != nil {
+        return err
+    }
+    social.Snapshot()
+    log.Printf("Replay finished")
+    return nil
+}
+
+// joinCluster starts this process as a cluster member. The member serves
+// queries from a replica of the engine and, when writer is given, also hosts
+// the engine itself.
+func joinCluster(system *actor.ActorSystem, config *remote.Config, name string, dir string, writer *engine.SocialEngine, admins []string) (*cluster.Cluster, *engine.SocialEngine) {
+    replica := engine.NewSocialEngine(storage.NewNopStore(), storage.NewNopJournal())
+    replica.SetAdmins(admins)
+    kinds := replica.GrainKinds()
+    if writer != nil {
+        kinds = append(kinds, writer.EngineKind())
+    }
+
+    members := cluster.New(system, cluster.Configure(
+        name,
+        discovery.NewFileProvider(dir),
+        disthash.New(),
+        config,
+        cluster.WithKinds(kinds...),
+    ))
+    replica.JoinCluster(members)
+    members.StartMember()
+    log.Printf("Joined cluster %s as member %s", name, system.ID)
+    return members, replica
+}
+
 func main() {
     // Define command line flags
     httpPort := flag.Int("port", 8080, "REST API port")
+    grpcAddr := flag.String("grpc-addr", "127.0.0.1:9090", "Address of the gRPC API for internal services (empty disables it)")
     actorPort := flag.Int("actor-port", 8085, "Actor system port")
+    dataDir := flag.String("data-dir", "data", "Directory for persisted state (empty keeps everything in memory)")
+    replayPath := flag.String("replay", "", "Journal to rebuild the engine state from instead of the last snapshot")
+    tokenSecret := flag.String("token-secret", "", "Key for signing auth tokens (defaults to a key kept in the data directory)")
+    tokenTTL := flag.Duration("token-ttl", 24*time.Hour, "Lifetime of issued auth tokens")
+    clusterName := flag.String("cluster", "", "Join the named cluster instead of running on a single node")
+    discoveryDir := flag.String("discovery-dir", "cluster", "Directory shared by cluster members to find each other")
+    mediaDir := flag.String("media-dir", "", "Directory for uploaded media (defaults to media under the data directory)")
+    maxUpload := flag.Int64("max-upload", 10<<20, "Largest media file accepted, in bytes")
+    admins := flag.String("admins", "", "Comma-separated usernames of site admins")
+    hostEngine := flag.Bool("engine", true, "Host the engine and its data on this cluster member (exactly one member should)")
+    limits := rest.DefaultRateLimits()
+    postRate := flag.Float64("rate-posts", limits.Quotas[rest.ActionPost].PerMinute, "Posts a user may submit per minute (0 disables the limit)")
+    commentRate := flag.Float64("rate-comments", limits.Quotas[rest.ActionComment].PerMinute, "Comments a user may submit per minute (0 disables the limit)")
+    voteRate := flag.Float64("rate-votes", limits.Quotas[rest.ActionVote].PerMinute, "Votes a user may cast per minute (0 disables the limit)")
+    messageRate := flag.Float64("rate-messages", limits.Quotas[rest.ActionMessage].PerMinute, "Direct messages a user may send per minute (0 disables the limit)")
+    signupRate := flag.Float64("rate-signups", limits.Quotas[rest.ActionSignup].PerMinute, "Accounts an IP address may create per minute (0 disables the limit)")
+    flag.Float64Var(&limits.IPMultiplier, "rate-ip-multiplier", limits.IPMultiplier, "How many users' worth of requests one IP address may make")
+    flag.DurationVar(&limits.NewcomerAge, "newcomer-age", limits.NewcomerAge, "Accounts younger than this get stricter rate limits")
+    newcomerKarma := flag.Int("newcomer-karma", int(limits.NewcomerKarma), "Accounts with less karma than this get stricter rate limits")
+    flag.Float64Var(&limits.NewcomerFactor, "newcomer-factor", limits.NewcomerFactor, "Share of the usual rate limits newcomers get")
     flag.Parse()
 
+    for action, rate := range map[string]float64{
+        rest.ActionPost:    *postRate,
+        rest.ActionComment: *commentRate,
+        rest.ActionVote:    *voteRate,
+        rest.ActionMessage: *messageRate,
+        rest.ActionSignup:  *signupRate,
+    } {
+        quota := limits.Quotas[action]
+        quota.PerMinute = rate
+        limits.Quotas[action] = quota
+    }
+    limits.NewcomerKarma = int32(*newcomerKarma)
+    if err := limits.Validate(); err != nil {
+        log.Fatalf("Invalid rate limits: %v", err)
+    }
+
+    if *clusterName != "" && *replayPath != "" {
+        log.Fatalf("Journal replay is not supported in cluster mode")
+    }
+
     // Setup logging
     logFile, err := setupLogging()
     if err != nil {
@@ -64,16 +165,62 @@ func main() {
         remote.WithAdvertisedHost("127.0.0.1"),
     )
 
-    // Start remote
-    r := remote.NewRemote(system, config)
-    r.Start()
-    log.Printf("Remote actor system started")
-    
-    // Create and start social engine actor
-    engine := engine.NewSocialEngine()
-    props := actor.PropsFromProducer(func() actor.Actor {
-        return engine
-    })
+    // Start remote; a cluster member starts it when joining
+    if *clusterName == "" {
+        r := remote.NewRemote(system, config)
+        r.Start()
+        log.Printf("Remote actor system started")
+    }
+
+    // Only the member hosting the engine keeps data
+    persist := *dataDir != "" && (*clusterName == "" || *hostEngine)
+
+    // Open the state store
+    var store storage.Store = storage.NewNopStore()
+    if persist {
+        fileStore, err := storage.NewFileStore(*dataDir)
+        if err != nil {
+            log.Fatalf("Failed to open data directory: %v", err)
+        }
+        store = fileStore
+        log.Printf("Persisting state to %s", *dataDir)
+    }
+    defer store.Close()
+
+    // Open the command journal
+    var journal storage.Journal = storage.NewNopJournal()
+    if persist {
+        fileJournal, err := storage.NewFileJournal(filepath.Join(*dataDir, "journal.log"))
+        if err != nil {
+            log.Fatalf("Failed to open journal: %v", err)
+        }
+        journal = fileJournal
+    }
+    defer journal.Close()
+
+    // Create, restore and start social engine actor. Admins are named first
+    // since replayed commands may depend on them.
+    adminList := strings.Split(*admins, ",")
+    social := engine.NewSocialEngine(store, journal)
+    social.SetAdmins(adminList)
+    if *replayPath == "" {
+        if err := social.Restore(); err != nil {
+            log.Fatalf("Failed to restore engine state: %v", err)
+        }
+    } else if err := replayJournal(social, store, journal, *replayPath); err != nil {
+        log.Fatalf("Failed to replay journal: %v", err)
+    }
+
+    // In a cluster the local actor is a replica that follows the engine
+    var members *cluster.Cluster
+    if *clusterName != "" {
+        var writer *engine.SocialEngine
+        if *hostEngine {
+            writer = social
+        }
+        members, social = joinCluster(system, config, *clusterName, *discoveryDir, writer, adminList)
+    }
+    props := social.ShardProps()
 
     pid, err := system.Root.SpawnNamed(props, "social")
     if err != nil {
@@ -81,8 +228,77 @@ func main() {
     }
     log.Printf("Social engine actor spawned with PID: %v", pid)
 
+    // Stop the engine on shutdown so it writes a final snapshot
+    go func() {
+        signals := make(chan os.Signal, 1)
+        signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+        sig := <-signals
+        log.Printf("Received %v, shutting down", sig)
+        if err := system.Root.PoisonFuture(pid).Wait(); err != nil {
+            log.Printf("Failed to stop engine: %v", err)
+        }
+        if members != nil {
+            members.Shutdown(true)
+        }
+        store.Close()
+        journal.Close()
+        logFile.Close()
+        os.Exit(0)
+    }()
+
+    // Load the token signing key
+    var key []byte
+    switch {
+    case *tokenSecret != "":
+        key = []byte(*tokenSecret)
+    case *clusterName != "":
+        // Every member must accept tokens issued by the others
+        key, err = auth.LoadOrCreateKey(filepath.Join(*discoveryDir, "token.key"))
+        if err != nil {
+            log.Fatalf("Failed to load token key: %v", err)
+        }
+    case *dataDir != "":
+        key, err = auth.LoadOrCreateKey(filepath.Join(*dataDir, "token.key"))
+        if err != nil {
+            log.Fatalf("Failed to load token key: %v", err)
+        }
+    default:
+        log.Printf("No token key configured, tokens will not survive a restart")
+        key = auth.NewKey()
+    }
+    tokens := auth.NewTokenIssuer(key, *tokenTTL)
+
+    // Start the gRPC API alongside the REST API
+    if *grpcAddr != "" {
+        grpcServer := rpc.NewServer(pid, system)
+        go func() {
+            if err := grpcServer.Start(*grpcAddr); err != nil {
+                log.Fatalf("gRPC server failed: %v", err)
+            }
+        }()
+    }
+
+    // Open the media store. Without a data directory uploads only last
+    // until the process exits.
+    mediaPath := *mediaDir
+    switch {
+    case mediaPath != "":
+    case *dataDir != "":
+        mediaPath = filepath.Join(*dataDir, "media")
+    default:
+        mediaPath, err = os.MkdirTemp("", "reddit-media-")
+        if err != nil {
+            log.Fatalf("Failed to create media directory: %v", err)
+        }
+    }
+    media, err := storage.NewBlobStore(mediaPath, *maxUpload)
+    if err != nil {
+        log.Fatalf("Failed to open media store: %v", err)
+    }
+    log.Printf("Storing media in %s", mediaPath)
+
     // Create and start REST API server
-    server := rest.NewServer(pid, system)
+    server := rest.NewServer(pid, system, tokens, media, limits)
     log.Printf("Starting REST server on port %d", *httpPort)
     
     // Start server and log any errors
@@ -0,0 +1,178 @@
+// discovery/file_provider.go
+package discovery
+
+import (
+    "encoding/json"
+    "fmt"
+    "log"
+    "os"
+    "path/filepath"
+    "strings"
+    "sync"
+    "time"
+    "github.com/asynkron/protoactor-go/cluster"
+)
+
+// Defaults for how often members announce themselves and how long an
+// announcement stays valid
+const (
+    DefaultRefreshInterval = 1 * time.Second
+    DefaultMemberTTL       = 5 * time.Second
+)
+
+const memberFileSuffix = ".member"
+
+// FileProvider discovers cluster members through a directory shared by all of
+// them, such as a directory on the local disk when every member runs on the
+// same machine. Each member keeps a file describing itself up to date; a
+// member whose file has not been refreshed within the TTL is considered gone.
+type FileProvider struct {
+    dir     string
+    refresh time.Duration
+    ttl     time.Duration
+    cluster *cluster.Cluster
+    self    *memberRecord
+    stop    chan struct{}
+    once    sync.Once
+}
+
+type memberRecord struct {
+    ID    string   `json:"id"`
+    Host  string   `json:"host"`
+    Port  int      `json:"port"`
+    Kinds []string `json:"kinds"`
+}
+
+func NewFileProvider(dir string) *FileProvider {
+    return &FileProvider{
+        dir:     dir,
+        refresh: DefaultRefreshInterval,
+        ttl:     DefaultMemberTTL,
+        stop:    make(chan struct{}),
+    }
+}
+
+func (p *FileProvider) StartMember(c *cluster.Cluster) error {
+    host, port, err := c.ActorSystem.GetHostPort()
+    if err != nil {
+        return err
+    }
+
+    p.cluster = c
+    p.self = &memberRecord{
+        ID:    c.ActorSystem.ID,
+        Host:  host,
+        Port:  port,
+        Kinds: c.GetClusterKinds(),
+    }
+    if err := os.MkdirAll(p.dir, 0755); err != nil {
+        return fmt.Errorf("failed to create discovery directory: %v", err)
+    }
+    if err := p.announce(); err != nil {
+        return err
+    }
+
+    log.Printf("Cluster member %s announced in %s", p.self.ID, p.dir)
+    go p.run()
+    return nil
+}
+
+func (p *FileProvider) StartClient(c *cluster.Cluster) error {
+    p.cluster = c
+    go p.run()
+    return nil
+}
+
+func (p *FileProvider) Shutdown(graceful bool) error {
+    p.once.Do(func() {
+        close(p.stop)
+    })
+    if p.self == nil {
+        return nil
+    }
+    if err := os.Remove(p.memberPath(p.self.ID)); err != nil && !os.IsNotExist(err) {
+        return fmt.Errorf("failed to remove member file: %v", err)
+    }
+    return nil
+}
+
+func (p *FileProvider) run() {
+    ticker := time.NewTicker(p.refresh)
+    defer ticker.Stop()
+
+    p.updateTopology()
+    for {
+        select {
+        case <-p.stop:
+            return
+        case <-ticker.C:
+            if p.self != nil {
+                if err := p.announce(); err != nil {
+                    log.Printf("Failed to announce cluster member: %v", err)
+                }
+            }
+            p.updateTopology()
+        }
+    }
+}
+
+// announce rewrites this member's file, which also refreshes its
+// modification time. The file is replaced atomically so readers never see
+// it half written.
+func (p *FileProvider) announce() error {
+    data, err := json.Marshal(p.self)
+    if err != nil {
+        return fmt.Errorf("failed to encode member: %v", err)
+    }
+
+    path := p.memberPath(p.self.ID)
+    tmp := path + ".tmp"
+    if err := os.WriteFile(tmp, data, 0644); err != nil {
+        return fmt.Errorf("failed to write member file: %v", err)
+    }
+    if err := os.Rename(tmp, path); err != nil {
+        return fmt.Errorf("failed to replace member file: %v", err)
+    }
+    return nil
+}
+
+// updateTopology reports the members with fresh files to the cluster
+func (p *FileProvider) updateTopology() {
+    entries, err := os.ReadDir(p.dir)
+    if err != nil {
+        log.Printf("Failed to read discovery directory: %v", err)
+        return
+    }
+
+    members := make([]*cluster.Member, 0, len(entries))
+    for _, entry := range entries {
+        if !strings.HasSuffix(entry.Name(), memberFileSuffix) {
+            continue
+        }
+        info, err := entry.Info()
+        if err != nil || time.Since(info.ModTime()) > p.ttl {
+            continue
+        }
+
+        data, err := os.ReadFile(filepath.Join(p.dir, entry.Name()))
+        if err != nil {
+            continue
+        }
+        var record memberRecord
+        if err := json.Unmarshal(data, &record); err != nil {
+            log.Printf("Skipping malformed member file %s: %v", entry.Name(), err)
+            continue
+        }
+        members = append(members, &cluster.Member{
+            Id:    record.ID,
+            Host:  record.Host,
+            Port:  int32(record.Port),
+            Kinds: record.Kinds,
+        })
+    }
+    p.cluster.MemberList.UpdateClusterTopology(members)
+}
+
+func (p *FileProvider) memberPath(id string) string {
+    return filepath.Join(p.dir, id+memberFileSuffix)
+}
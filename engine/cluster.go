@@ -0,0 +1,259 @@
+// engine/cluster.go
+package engine
+
+import (
+    "log"
+    "time"
+    "github.com/asynkron/protoactor-go/actor"
+    "github.com/asynkron/protoactor-go/cluster"
+    protobuf "google.golang.org/protobuf/proto"
+    "reddit/proto"
+)
+
+// Grain kinds hosted by cluster members
+const (
+    GrainEngine = "engine"
+    GrainForum  = "forum"
+    GrainUser   = "user"
+)
+
+// engineIdentity names the one engine grain in a cluster
+const engineIdentity = "social"
+
+// followRetry is how long a replica waits before looking for the engine
+// again
+const followRetry = 2 * time.Second
+
+// snapshotChunkSize is how many records are sent to a new replica at a time
+const snapshotChunkSize = 500
+
+// In a cluster one member hosts the engine grain, which journals and applies
+// every command. Every member runs a replica that loads a snapshot of the
+// engine's state, then follows its journal and serves queries, so any member
+// can answer any request. Forums and users are grains spread over the
+// members, each answering queries from the replica of the member it was
+// placed on.
+
+// followLeader asks a replica to look for the engine and follow it
+type followLeader struct{}
+
+// leaderFound tells a replica where the engine is, or that it was not found
+type leaderFound struct {
+    pid *actor.PID
+}
+
+// JoinCluster turns the engine into a replica of the engine grain in c. It
+// must be called before the engine is spawned.
+func (s *SocialEngine) JoinCluster(c *cluster.Cluster) {
+    s.cluster = c
+}
+
+// GrainKinds returns the forum and user grains, which serve queries from this
+// engine
+func (s *SocialEngine) GrainKinds() []*cluster.Kind {
+    props := actor.PropsFromProducer(func() actor.Actor {
+        return &shard{engine: s, clustered: true}
+    })
+    return []*cluster.Kind{
+        cluster.NewKind(GrainForum, props),
+        cluster.NewKind(GrainUser, props),
+    }
+}
+
+// EngineKind returns the engine grain. Only the member holding the engine's
+// data should host it.
+func (s *SocialEngine) EngineKind() *cluster.Kind {
+    return cluster.NewKind(GrainEngine, s.ShardProps())
+}
+
+// follow looks for the engine. Looking up a grain may wait on other
+// members, so it is done off the actor, which is told what was found.
+func (s *SocialEngine) follow(context actor.Context) {
+    members, system, self := s.cluster, context.ActorSystem(), context.Self()
+    go func() {
+        system.Root.Send(self, &leaderFound{pid: members.Get(engineIdentity, GrainEngine)})
+    }()
+}
+
+// handleLeaderFound subscribes a replica to the engine it found
+func (s *SocialEngine) handleLeaderFound(context actor.Context, msg *leaderFound) {
+    if msg.pid == nil {
+        log.Printf("Engine not available, retrying in %v", followRetry)
+        s.retryFollow(context)
+        return
+    }
+
+    s.leader = msg.pid
+    context.Watch(msg.pid)
+    context.Request(msg.pid, &proto.Follow{})
+    log.Printf("Following engine %v", msg.pid)
+}
+
+func (s *SocialEngine) retryFollow(context actor.Context) {
+    system, self := context.ActorSystem(), context.Self()
+    time.AfterFunc(followRetry, func() {
+        system.Root.Send(self, &followLeader{})
+    })
+}
+
+// forwardCommand passes a command on to the engine, which answers the
+// original sender directly. Until the replica has found the engine the
+// command is refused, so the sender need not wait for an answer.
+func (s *SocialEngine) forwardCommand(context actor.Context) {
+    if s.leader == nil {
+        log.Printf("Engine not available, refusing %T", context.Message())
+        context.Respond(&proto.EngineUnavailable{
+            Message: "Engine not available, try again shortly",
+        })
+        return
+    }
+    context.Forward(s.leader)
+}
+
+// handleFollow registers a replica and sends it the current state. Journal
+// entries recorded from then on follow it, in order.
+func (s *SocialEngine) handleFollow(context actor.Context, msg *proto.Follow) {
+    sender := context.Sender()
+    if sender == nil {
+        return
+    }
+
+    s.followers[pidKey(sender)] = sender
+    context.Watch(sender)
+
+    chunks := s.sendSnapshot(context, sender)
+    log.Printf("Replica %v following from entry %d, sent %d snapshot chunks", sender, s.sequence, chunks)
+}
+
+// sendSnapshot sends the state to a replica a few records at a time, in the
+// order a snapshot is applied in, and returns how many chunks it sent. Each
+// chunk is a copy, since the state changes while chunks are on their way.
+// Follow waits for the shards to be idle, so no command changes the state
+// while it is copied.
+func (s *SocialEngine) sendSnapshot(context actor.Context, pid *actor.PID) int {
+    state := s.snapshotState()
+    chunk := &proto.EngineSnapshot{TakenAt: state.TakenAt}
+    size, sent := 0, 0
+    flush := func(last bool) {
+        context.Request(pid, protobuf.Clone(&proto.SnapshotChunk{
+            Sequence: state.Sequence,
+            State:    chunk,
+            First:    sent == 0,
+            Last:     last,
+        }))
+        chunk = &proto.EngineSnapshot{TakenAt: state.TakenAt}
+        size = 0
+        sent++
+    }
+    added := func() {
+        if size++; size == snapshotChunkSize {
+            flush(false)
+        }
+    }
+
+    for _, user := range state.Users {
+        chunk.Users = append(chunk.Users, user)
+        added()
+    }
+    for _, content := range state.Contents {
+        chunk.Contents = append(chunk.Contents, content)
+        added()
+    }
+    for _, forum := range state.Forums {
+        chunk.Forums = append(chunk.Forums, forum)
+        added()
+    }
+    for _, chats := range state.Chats {
+        chunk.Chats = append(chunk.Chats, chats)
+        added()
+    }
+    for _, notification := range state.Notifications {
+        chunk.Notifications = append(chunk.Notifications, notification)
+        added()
+    }
+    for _, vote := range state.Ledger {
+        chunk.Ledger = append(chunk.Ledger, vote)
+        added()
+    }
+    flush(true)
+    return sent
+}
+
+// applySnapshotChunk loads the state sent by the engine. The first chunk
+// replaces whatever the replica held before, so a replica that follows the
+// engine again starts over from its state.
+func (s *SocialEngine) applySnapshotChunk(context actor.Context, chunk *proto.SnapshotChunk) {
+    if !s.fromLeader(context) {
+        log.Printf("Ignoring snapshot from %v", context.Sender())
+        return
+    }
+
+    if chunk.First {
+        s.reset()
+    }
+    if chunk.State != nil {
+        s.applySnapshot(chunk.State)
+    }
+    if chunk.Last {
+        s.rebuild()
+        s.sequence = chunk.Sequence
+        log.Printf("Loaded %d users, %d forums, %d posts and %d comments as of entry %d",
+            s.users.len(), s.forums.len(), s.contents.len(), s.feedbacks.len(), s.sequence)
+    }
+}
+
+// replicate sends a journaled command to every replica. It is sent as a
+// request so replicas can tell it came from the engine. Replicas apply the
+// commands one at a time in journal order, whichever shards applied them
+// here.
+func (s *SocialEngine) replicate(context actor.Context, entry *proto.JournalEntry) {
+    if entry.Command == nil {
+        return
+    }
+    for _, pid := range s.followers {
+        context.Request(pid, entry)
+    }
+}
+
+// applyReplicated applies a journal entry from the engine, skipping entries
+// the replica has already seen. Entries from anyone but the engine are
+// refused, since they would change the state without being journaled.
+func (s *SocialEngine) applyReplicated(context actor.Context, entry *proto.JournalEntry) {
+    if !s.fromLeader(context) {
+        log.Printf("Ignoring journal entry %d from %v", entry.Sequence, context.Sender())
+        return
+    }
+    if entry.Sequence <= s.sequence {
+        return
+    }
+    s.replay(silentContext{context}, entry)
+    s.compactIfDue(context)
+}
+
+// fromLeader reports whether the message being handled came from the
+// engine this replica follows
+func (s *SocialEngine) fromLeader(context actor.Context) bool {
+    sender := context.Sender()
+    return s.leader != nil && sender != nil && pidKey(sender) == pidKey(s.leader)
+}
+
+// replicaTerminated handles the engine or a replica stopping
+func (s *SocialEngine) replicaTerminated(context actor.Context, who *actor.PID) bool {
+    if s.leader != nil && pidKey(s.leader) == pidKey(who) {
+        s.leader = nil
+        log.Printf("Engine %v stopped, following it again in %v", who, followRetry)
+        s.retryFollow(context)
+        return true
+    }
+
+    if _, exists := s.followers[pidKey(who)]; exists {
+        delete(s.followers, pidKey(who))
+        log.Printf("Replica %v stopped following", who)
+        return true
+    }
+    return false
+}
+
+func pidKey(pid *actor.PID) string {
+    return pid.Address + "/" + pid.Id
+}
@@ -0,0 +1,255 @@
+// engine/comment_tree.go
+package engine
+
+import (
+    "encoding/base64"
+    "strings"
+    "github.com/asynkron/protoactor-go/actor"
+    "reddit/proto"
+    "reddit/utils"
+)
+
+// Tree shape used when a request does not ask for one or asks for too much
+const (
+    defaultTreeDepth    = 6
+    maxTreeDepth        = 20
+    defaultTreeChildren = 20
+)
+
+// commentListing orders comments for the given sort method, best first by
+// default
+func (s *SocialEngine) commentListing(sortMethod string) (listing[*proto.Feedback], bool) {
+    switch sortMethod {
+    case "", "best":
+        return listing[*proto.Feedback]{key: func(feedback *proto.Feedback) cursor {
+            ups, downs := s.reactionCounts(feedback.Reactions)
+            return cursor{utils.CalculateBestScore(ups, downs), feedback.FeedbackId}
+        }}, true
+    case "top":
+        return listing[*proto.Feedback]{key: func(feedback *proto.Feedback) cursor {
+            return cursor{float64(feedback.Points), feedback.FeedbackId}
+        }}, true
+    case "new":
+        return listing[*proto.Feedback]{key: func(feedback *proto.Feedback) cursor {
+            return cursor{float64(feedback.Timestamp), feedback.FeedbackId}
+        }}, true
+    case "old":
+        return replyListing, true
+    case "controversial":
+        return listing[*proto.Feedback]{key: func(feedback *proto.Feedback) cursor {
+            ups, downs := s.reactionCounts(feedback.Reactions)
+            return cursor{utils.CalculateControversyScore(ups, downs), feedback.FeedbackId}
+        }}, true
+    }
+    return listing[*proto.Feedback]{}, false
+}
+
+// A continuation names the comment whose replies to resume, or none for the
+// post's top-level comments, and the position among them to resume after
+type continuation struct {
+    parent string
+    after  string
+}
+
+func (c continuation) encode() string {
+    return base64.RawURLEncoding.EncodeToString([]byte(c.parent + "\x00" + c.after))
+}
+
+func decodeContinuation(encoded string) (continuation, error) {
+    raw, err := base64.RawURLEncoding.DecodeString(encoded)
+    if err != nil {
+        return continuation{}, errInvalidCursor
+    }
+    parent, after, found := strings.Cut(string(raw), "\x00")
+    if !found {
+        return continuation{}, errInvalidCursor
+    }
+    return continuation{parent: parent, after: after}, nil
+}
+
+// commentTree builds trees of detached comments, collapsing what lies
+// beyond its depth and breadth into MoreComments stubs
+type commentTree struct {
+    order       listing[*proto.Feedback]
+    maxDepth    int
+    maxChildren int32
+}
+
+// ranked returns the tree's order with each of the replies keyed once, since
+// best and controversial keys count every vote
+func (t commentTree) ranked(replies []*proto.Feedback) listing[*proto.Feedback] {
+    keys := make(map[string]cursor, len(replies))
+    for _, reply := range replies {
+        keys[reply.FeedbackId] = t.order.key(reply)
+    }
+    return listing[*proto.Feedback]{
+        key: func(feedback *proto.Feedback) cursor {
+            return keys[feedback.FeedbackId]
+        },
+        ascending: t.order.ascending,
+    }
+}
+
+// branch lays out the replies to parent, starting after the given cursor
+func (t commentTree) branch(parent string, replies []*proto.Feedback, after string, depth int) ([]*proto.CommentNode, *proto.MoreComments, error) {
+    order := t.ranked(replies)
+    sorted := order.sorted(replies)
+    result, err := order.paginate(sorted, after, "", t.maxChildren)
+    if err != nil {
+        return nil, nil, err
+    }
+
+    nodes := make([]*proto.CommentNode, 0, len(result.items))
+    for _, reply := range result.items {
+        node := &proto.CommentNode{Comment: detachedFeedback(reply)}
+        if len(reply.Replies) > 0 {
+            if depth+1 >= t.maxDepth {
+                node.More = &proto.MoreComments{
+                    ParentId:     reply.FeedbackId,
+                    Count:        threadSize(reply.Replies),
+                    Continuation: continuation{parent: reply.FeedbackId}.encode(),
+                }
+            } else {
+                node.Replies, node.More, err = t.branch(reply.FeedbackId, reply.Replies, "", depth+1)
+                if err != nil {
+                    return nil, nil, err
+                }
+            }
+        }
+        nodes = append(nodes, node)
+    }
+
+    if result.next == "" {
+        return nodes, nil, nil
+    }
+    shown := len(result.items)
+    for i, reply := range sorted {
+        if reply == result.items[shown-1] {
+            return nodes, &proto.MoreComments{
+                ParentId:     parent,
+                Count:        threadSize(sorted[i+1:]),
+                Continuation: continuation{parent: parent, after: result.next}.encode(),
+            }, nil
+        }
+    }
+    return nodes, nil, nil
+}
+
+// threadSize counts comments and all their replies
+func threadSize(comments []*proto.Feedback) int32 {
+    var count int32
+    for _, comment := range comments {
+        count += 1 + threadSize(comment.Replies)
+    }
+    return count
+}
+
+func (s *SocialEngine) handleGetCommentTree(context actor.Context, msg *proto.GetCommentTree) {
+    // A tree rooted at a comment holds just that comment and its replies
+    contentId := msg.ContentId
+    var root *proto.Feedback
+    if msg.FeedbackId != "" {
+        feedback, exists := s.feedbacks.get(msg.FeedbackId)
+        if !exists {
+            context.Respond(&proto.CommentTree{
+                Success: false,
+                Message: "Comment not found",
+            })
+            return
+        }
+        contentId, root = feedback.ContentId, feedback
+    }
+
+    message := "Post not found"
+    if root != nil {
+        message = "Comment not found"
+    }
+    content, forum, exists := s.lookupContent(contentId)
+    if !exists {
+        context.Respond(&proto.CommentTree{
+            Success: false,
+            Message: message,
+        })
+        return
+    }
+
+    forum.mutex.RLock()
+    defer forum.mutex.RUnlock()
+
+    if !s.canViewContent(content, msg.Viewer) {
+        context.Respond(&proto.CommentTree{
+            Success: false,
+            Message: message,
+        })
+        return
+    }
+
+    order, known := s.commentListing(msg.SortMethod)
+    if !known {
+        context.Respond(&proto.CommentTree{
+            Success: false,
+            Message: "Unknown sort method",
+        })
+        return
+    }
+
+    tree := commentTree{
+        order:       order,
+        maxDepth:    int(msg.MaxDepth),
+        maxChildren: msg.MaxChildren,
+    }
+    if tree.maxDepth <= 0 {
+        tree.maxDepth = defaultTreeDepth
+    }
+    if tree.maxDepth > maxTreeDepth {
+        tree.maxDepth = maxTreeDepth
+    }
+    if tree.maxChildren <= 0 {
+        tree.maxChildren = defaultTreeChildren
+    }
+
+    // Top-level comments hang off the post; a continuation may pick up
+    // below one of its comments
+    parent, replies, after := "", content.Feedback, ""
+    if root != nil {
+        parent, replies = root.ParentId, []*proto.Feedback{root}
+    }
+    if msg.Continuation != "" {
+        position, err := decodeContinuation(msg.Continuation)
+        if err != nil {
+            context.Respond(&proto.CommentTree{
+                Success: false,
+                Message: "Invalid continuation",
+            })
+            return
+        }
+        if position.parent != "" {
+            feedback, found := s.feedbacks.get(position.parent)
+            if !found || feedback.ContentId != content.ContentId {
+                context.Respond(&proto.CommentTree{
+                    Success: false,
+                    Message: "Invalid continuation",
+                })
+                return
+            }
+            parent, replies = feedback.FeedbackId, feedback.Replies
+        }
+        after = position.after
+    }
+
+    comments, more, err := tree.branch(parent, replies, after, 0)
+    if err != nil {
+        context.Respond(&proto.CommentTree{
+            Success: false,
+            Message: "Invalid continuation",
+        })
+        return
+    }
+
+    context.Respond(&proto.CommentTree{
+        Success:  true,
+        Message:  "Comments retrieved successfully",
+        Comments: comments,
+        More:     more,
+    })
+}
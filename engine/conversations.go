@@ -0,0 +1,214 @@
+// engine/conversations.go
+package engine
+
+import (
+    "log"
+    "sort"
+    "github.com/asynkron/protoactor-go/actor"
+    protobuf "google.golang.org/protobuf/proto"
+    "reddit/proto"
+)
+
+// insertChat files a message in a thread, keeping it in listing order
+func insertChat(messages []*proto.DirectChat, chat *proto.DirectChat) []*proto.DirectChat {
+    position := sort.Search(len(messages), func(i int) bool {
+        return chatListing.key(chat).precedes(chatListing.key(messages[i]), chatListing.ascending)
+    })
+    messages = append(messages, nil)
+    copy(messages[position+1:], messages[position:])
+    messages[position] = chat
+    return messages
+}
+
+// addToConversation threads a delivered message for both of its users.
+// The threads and the receiver's inbox share the message, so read receipts
+// show up everywhere. Callers hold both users' locks.
+func addToConversation(sender, receiver *UserData, chat *proto.DirectChat) {
+    if sender.threads == nil {
+        sender.threads = make(map[string][]*proto.DirectChat)
+    }
+    sender.threads[receiver.Handle] = insertChat(sender.threads[receiver.Handle], chat)
+    if receiver == sender {
+        return
+    }
+    if receiver.threads == nil {
+        receiver.threads = make(map[string][]*proto.DirectChat)
+    }
+    receiver.threads[sender.Handle] = insertChat(receiver.threads[sender.Handle], chat)
+}
+
+// rebuildConversations threads every inbox after a restore or a cleanup.
+// Messages stored before they were numbered stay unnumbered; chatListing
+// places them first. Only delivering a message numbers and stores it, so a
+// restore or a replica never writes.
+func (s *SocialEngine) rebuildConversations() {
+    for _, user := range s.users.all() {
+        user.mutex.Lock()
+        user.threads = nil
+        user.mutex.Unlock()
+    }
+    for _, receiver := range s.users.all() {
+        receiver.mutex.RLock()
+        inbox := append([]*proto.DirectChat(nil), receiver.inbox...)
+        receiver.mutex.RUnlock()
+
+        for _, chat := range inbox {
+            sender, exists := s.users.get(chat.Sender)
+            if !exists {
+                // Only the receiver is left to thread it for
+                sender = &UserData{Handle: chat.Sender}
+            }
+            unlock := lockUsers(sender, receiver)
+            addToConversation(sender, receiver, chat)
+            unlock()
+        }
+    }
+}
+
+// clonedChats copies messages so that they can be sent once their users'
+// locks are released
+func clonedChats(chats []*proto.DirectChat) []*proto.DirectChat {
+    cloned := make([]*proto.DirectChat, len(chats))
+    for i, chat := range chats {
+        cloned[i] = protobuf.Clone(chat).(*proto.DirectChat)
+    }
+    return cloned
+}
+
+func (s *SocialEngine) handleGetConversations(context actor.Context, msg *proto.GetConversations) {
+    user, exists := s.users.get(msg.UserHandle)
+    if !exists {
+        context.Respond(&proto.ConversationList{
+            Success: false,
+            Message: "User not found",
+        })
+        return
+    }
+
+    user.mutex.RLock()
+    defer user.mutex.RUnlock()
+
+    var unread int32
+    conversations := make([]*proto.ConversationSummary, 0)
+    for peer, messages := range user.threads {
+        summary := &proto.ConversationSummary{
+            Peer:         peer,
+            LastMessage:  protobuf.Clone(messages[len(messages)-1]).(*proto.DirectChat),
+            MessageCount: int32(len(messages)),
+        }
+        for _, chat := range messages {
+            if chat.Receiver == msg.UserHandle && !chat.Seen {
+                summary.UnreadCount++
+            }
+        }
+        unread += summary.UnreadCount
+        conversations = append(conversations, summary)
+    }
+
+    // Most recently active first
+    sort.Slice(conversations, func(i, j int) bool {
+        a, b := conversations[i].LastMessage, conversations[j].LastMessage
+        return chatListing.key(b).precedes(chatListing.key(a), chatListing.ascending)
+    })
+
+    context.Respond(&proto.ConversationList{
+        Success:       true,
+        Message:       "Conversations retrieved successfully",
+        Conversations: conversations,
+        UnreadCount:   unread,
+    })
+}
+
+func (s *SocialEngine) handleGetConversation(context actor.Context, msg *proto.GetConversation) {
+    user, exists := s.users.get(msg.UserHandle)
+    if !exists {
+        context.Respond(&proto.ChatBundle{
+            Success: false,
+            Message: "User not found",
+        })
+        return
+    }
+
+    user.mutex.RLock()
+    defer user.mutex.RUnlock()
+
+    messages := user.threads[msg.Peer]
+    result, err := chatListing.paginate(messages, msg.After, msg.Before, msg.Limit)
+    if err != nil {
+        context.Respond(&proto.ChatBundle{
+            Success: false,
+            Message: "Invalid cursor",
+        })
+        return
+    }
+
+    context.Respond(&proto.ChatBundle{
+        Success:    true,
+        Message:    "Conversation retrieved successfully",
+        Messages:   clonedChats(result.items),
+        NextCursor: result.next,
+        PrevCursor: result.prev,
+    })
+}
+
+// handleMarkRead marks the messages a peer sent to the user as read, up to
+// and including the given message or all of them. Each sender is told which
+// of their messages were read.
+func (s *SocialEngine) handleMarkRead(context actor.Context, msg *proto.MarkRead) {
+    reader, exists := s.users.get(msg.UserHandle)
+    if !exists {
+        context.Respond(&proto.MarkReadResponse{
+            Success: false,
+            Message: "User not found",
+        })
+        return
+    }
+
+    // The peer's copy of the thread shares the messages, so both users are
+    // locked while they change
+    peer, exists := s.users.get(msg.Peer)
+    if !exists {
+        peer = reader
+    }
+    unlock := lockUsers(reader, peer)
+    defer unlock()
+
+    messages := reader.threads[msg.Peer]
+    end := len(messages)
+    if msg.MessageId != "" {
+        end = -1
+        for i, chat := range messages {
+            if chat.MessageId == msg.MessageId {
+                end = i + 1
+                break
+            }
+        }
+        if end < 0 {
+            context.Respond(&proto.MarkReadResponse{
+                Success: false,
+                Message: "Message not found",
+            })
+            return
+        }
+    }
+
+    var marked int32
+    readAt := s.now(context).Unix()
+    for _, chat := range messages[:end] {
+        if chat.Receiver != msg.UserHandle || chat.Seen {
+            continue
+        }
+        chat.Seen = true
+        chat.ReadAt = readAt
+        s.persistChat(chat)
+        s.publishRead(context, chat)
+        marked++
+    }
+
+    log.Printf("User %s read %d messages from %s", msg.UserHandle, marked, msg.Peer)
+    context.Respond(&proto.MarkReadResponse{
+        Success: true,
+        Message: "Messages marked as read",
+        Marked:  marked,
+    })
+}
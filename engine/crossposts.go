@@ -0,0 +1,166 @@
+// engine/crossposts.go
+package engine
+
+import (
+    "github.com/asynkron/protoactor-go/actor"
+    "reddit/proto"
+)
+
+// Shares link posts in different forums, so the engine applies the commands
+// that create or take down a share or an original itself rather than on a
+// forum's shard. They lock the linked forum along with their own; nothing
+// else ever holds two forum locks.
+
+// lockLinked locks the forum of a linked post for writing, unless it is the
+// forum already held. It returns the function that unlocks it.
+func lockLinked(forum, held *ForumData) func() {
+    if forum == held {
+        return func() {}
+    }
+    forum.mutex.Lock()
+    return forum.mutex.Unlock
+}
+
+// linkCrosspost checks that a shared post points at a live original and
+// records where the original came from. A share of a share points at the
+// first post. It returns a message explaining why the post was rejected, or
+// an empty string. Callers hold the lock of forum, the share's forum.
+func (s *SocialEngine) linkCrosspost(forum *ForumData, content *proto.Content) string {
+    if !content.IsShare {
+        content.OriginalContentId = ""
+        return ""
+    }
+
+    original, originalForum, exists := s.lookupContent(content.OriginalContentId)
+    if exists && original.IsShare {
+        original, originalForum, exists = s.lookupContent(original.OriginalContentId)
+    }
+    if !exists {
+        return "Original post not found"
+    }
+
+    unlock := lockLinked(originalForum, forum)
+    defer unlock()
+
+    if !isVisible(original) {
+        return "Original post not found"
+    }
+    if originalForum.Visibility == VisibilityPrivate {
+        return "Posts from private forums cannot be crossposted"
+    }
+
+    content.OriginalContentId = original.ContentId
+    content.Original = &proto.OriginalPost{
+        Creator:   original.Creator,
+        Subreddit: original.Subreddit,
+    }
+    return ""
+}
+
+// addCrosspost counts a new share against its original. Callers hold the
+// lock of forum, the share's forum.
+func (s *SocialEngine) addCrosspost(forum *ForumData, share *proto.Content) {
+    original, originalForum, exists := s.lookupContent(share.OriginalContentId)
+    if !exists {
+        return
+    }
+
+    unlock := lockLinked(originalForum, forum)
+    defer unlock()
+
+    s.crossposts.add(original.ContentId, share)
+    original.CrosspostCount++
+    s.persistContent(original)
+}
+
+// contentGone reflects a post being deleted or removed in the posts it is
+// linked to: a share no longer counts against its original, and the shares
+// of an original show that it is unavailable. Its comments leave the search
+// index with it. It is called once the post has been changed, so shares
+// pick up a deleted post's hidden author. Callers hold the lock of forum,
+// the post's forum.
+func (s *SocialEngine) contentGone(forum *ForumData, content *proto.Content) {
+    s.unindexThread(content.Feedback)
+
+    if content.IsShare {
+        if original, originalForum, exists := s.lookupContent(content.OriginalContentId); exists {
+            unlock := lockLinked(originalForum, forum)
+            if original.CrosspostCount > 0 {
+                original.CrosspostCount--
+                s.persistContent(original)
+            }
+            unlock()
+        }
+    }
+
+    for _, share := range s.crossposts.list(content.ContentId) {
+        shareForum, exists := s.forums.get(share.Subreddit)
+        if !exists {
+            continue
+        }
+        unlock := lockLinked(shareForum, forum)
+        if share.Original != nil && !share.Original.Unavailable {
+            share.Original.Unavailable = true
+            share.Original.Creator = shownCreator(content.Creator, content.IsDeleted)
+            s.persistContent(share)
+        }
+        unlock()
+    }
+}
+
+// rebuildCrossposts links every share to its original after a restore
+func (s *SocialEngine) rebuildCrossposts() {
+    s.crossposts.clear()
+    for _, content := range s.contents.all() {
+        if content.IsShare && content.OriginalContentId != "" {
+            s.crossposts.add(content.OriginalContentId, content)
+        }
+    }
+}
+
+func (s *SocialEngine) handleGetCrossposts(context actor.Context, msg *proto.GetCrossposts) {
+    original, forum, exists := s.lookupContent(msg.ContentId)
+    if exists {
+        forum.mutex.RLock()
+        exists = s.canViewContent(original, msg.Viewer)
+        forum.mutex.RUnlock()
+    }
+    if !exists {
+        context.Respond(&proto.CrosspostList{
+            Success: false,
+            Message: "Post not found",
+        })
+        return
+    }
+
+    listing, _ := s.ranking("new", "", rankingTime(msg.After, msg.Before))
+    shares := listing.gather()
+    for _, share := range s.crossposts.list(original.ContentId) {
+        shareForum, exists := s.forums.get(share.Subreddit)
+        if !exists {
+            continue
+        }
+        shareForum.mutex.RLock()
+        if shareForum.canView(msg.Viewer) {
+            shares.add(share)
+        }
+        shareForum.mutex.RUnlock()
+    }
+
+    result, err := shares.paginate(msg.After, msg.Before, msg.Limit)
+    if err != nil {
+        context.Respond(&proto.CrosspostList{
+            Success: false,
+            Message: "Invalid cursor",
+        })
+        return
+    }
+
+    context.Respond(&proto.CrosspostList{
+        Success:    true,
+        Message:    "Crossposts retrieved successfully",
+        Contents:   s.shownPosts(result.items),
+        NextCursor: result.next,
+        PrevCursor: result.prev,
+    })
+}
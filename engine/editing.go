@@ -0,0 +1,268 @@
+// engine/editing.go
+package engine
+
+import (
+    "log"
+    "github.com/asynkron/protoactor-go/actor"
+    protobuf "google.golang.org/protobuf/proto"
+    "reddit/proto"
+)
+
+const (
+    deletedBody    = "[deleted]"
+    deletedCreator = "[deleted]"
+)
+
+// isVisible reports whether a post still shows up in listings
+func isVisible(content *proto.Content) bool {
+    return !content.IsRemoved && !content.IsDeleted
+}
+
+// shownCreator is the author shown for a post or comment. Deleted items keep
+// their real author, so that votes on them still reach the right karma, but
+// responses do not reveal it.
+func shownCreator(creator string, deleted bool) string {
+    if deleted {
+        return deletedCreator
+    }
+    return creator
+}
+
+// shownContent copies a post as it is shown, masking the authors of its
+// deleted comments. The copy can still be read once the lock is released.
+// Callers hold the lock of the post's forum.
+func shownContent(content *proto.Content) *proto.Content {
+    shown := protobuf.Clone(content).(*proto.Content)
+    maskFeedback(shown.Feedback)
+    return shown
+}
+
+// shownContents copies the posts of a listing as they are shown. Callers
+// hold the lock of the posts' forum.
+func shownContents(contents []*proto.Content) []*proto.Content {
+    shown := make([]*proto.Content, len(contents))
+    for i, content := range contents {
+        shown[i] = shownContent(content)
+    }
+    return shown
+}
+
+// shownPosts copies the posts of a listing gathered from several forums,
+// locking each post's forum while it is copied
+func (s *SocialEngine) shownPosts(contents []*proto.Content) []*proto.Content {
+    shown := make([]*proto.Content, 0, len(contents))
+    for _, content := range contents {
+        forum, exists := s.forums.get(content.Subreddit)
+        if !exists {
+            continue
+        }
+        forum.mutex.RLock()
+        shown = append(shown, shownContent(content))
+        forum.mutex.RUnlock()
+    }
+    return shown
+}
+
+func maskFeedback(feedbacks []*proto.Feedback) {
+    for _, feedback := range feedbacks {
+        feedback.Creator = shownCreator(feedback.Creator, feedback.IsDeleted)
+        maskFeedback(feedback.Replies)
+    }
+}
+
+func (s *SocialEngine) handleEditContent(context actor.Context, msg *proto.EditContent) {
+    content, forum, exists := s.lookupContent(msg.ContentId)
+    if !exists {
+        context.Respond(&proto.EditResponse{
+            Success: false,
+            Message: "Content not found",
+        })
+        return
+    }
+
+    forum.mutex.Lock()
+    defer forum.mutex.Unlock()
+
+    if content.Creator != msg.UserHandle {
+        context.Respond(&proto.EditResponse{
+            Success: false,
+            Message: "Only the author can edit content",
+        })
+        return
+    }
+
+    if !isVisible(content) {
+        context.Respond(&proto.EditResponse{
+            Success: false,
+            Message: "Content can no longer be edited",
+        })
+        return
+    }
+
+    heading := msg.Heading
+    if heading == "" {
+        heading = content.Heading
+    }
+
+    content.Revisions = append(content.Revisions, &proto.Revision{
+        Heading:   content.Heading,
+        Body:      content.Body,
+        Timestamp: lastChange(content.Timestamp, content.EditedAt),
+    })
+    content.Heading = heading
+    content.Body = msg.Body
+    content.EditedAt = s.now(context).Unix()
+    s.persistContent(content)
+
+    log.Printf("Content %s edited by %s", msg.ContentId, msg.UserHandle)
+    context.Respond(&proto.EditResponse{
+        Success: true,
+        Message: "Content edited successfully",
+    })
+}
+
+// handleDeleteContent is applied by the engine rather than a shard, since a
+// deleted post changes the shares and originals it is linked to
+func (s *SocialEngine) handleDeleteContent(context actor.Context, msg *proto.DeleteContent) {
+    content, forum, exists := s.lookupContent(msg.ContentId)
+    if !exists {
+        context.Respond(&proto.EditResponse{
+            Success: false,
+            Message: "Content not found",
+        })
+        return
+    }
+
+    forum.mutex.Lock()
+    defer forum.mutex.Unlock()
+
+    if content.Creator != msg.UserHandle {
+        context.Respond(&proto.EditResponse{
+            Success: false,
+            Message: "Only the author can delete content",
+        })
+        return
+    }
+
+    if content.IsDeleted {
+        context.Respond(&proto.EditResponse{
+            Success: false,
+            Message: "Content already deleted",
+        })
+        return
+    }
+
+    // The post is kept as a tombstone so its comments stay reachable. Its
+    // author is kept too, and only masked in responses.
+    wasVisible := isVisible(content)
+    content.IsDeleted = true
+    content.Body = deletedBody
+    content.Revisions = nil
+    content.EditedAt = s.now(context).Unix()
+    s.persistContent(content)
+    if wasVisible {
+        s.contentGone(forum, content)
+    }
+
+    log.Printf("Content %s deleted by %s", msg.ContentId, msg.UserHandle)
+    context.Respond(&proto.EditResponse{
+        Success: true,
+        Message: "Content deleted successfully",
+    })
+}
+
+func (s *SocialEngine) handleEditFeedback(context actor.Context, msg *proto.EditFeedback) {
+    feedback, _, forum, exists := s.lookupFeedback(msg.FeedbackId)
+    if !exists {
+        context.Respond(&proto.EditResponse{
+            Success: false,
+            Message: "Feedback not found",
+        })
+        return
+    }
+
+    forum.mutex.Lock()
+    defer forum.mutex.Unlock()
+
+    if feedback.Creator != msg.UserHandle {
+        context.Respond(&proto.EditResponse{
+            Success: false,
+            Message: "Only the author can edit feedback",
+        })
+        return
+    }
+
+    if feedback.IsRemoved || feedback.IsDeleted {
+        context.Respond(&proto.EditResponse{
+            Success: false,
+            Message: "Feedback can no longer be edited",
+        })
+        return
+    }
+
+    feedback.Revisions = append(feedback.Revisions, &proto.Revision{
+        Body:      feedback.Body,
+        Timestamp: lastChange(feedback.Timestamp, feedback.EditedAt),
+    })
+    feedback.Body = msg.Body
+    feedback.EditedAt = s.now(context).Unix()
+    s.persistFeedback(feedback)
+
+    log.Printf("Feedback %s edited by %s", msg.FeedbackId, msg.UserHandle)
+    context.Respond(&proto.EditResponse{
+        Success: true,
+        Message: "Feedback edited successfully",
+    })
+}
+
+func (s *SocialEngine) handleDeleteFeedback(context actor.Context, msg *proto.DeleteFeedback) {
+    feedback, _, forum, exists := s.lookupFeedback(msg.FeedbackId)
+    if !exists {
+        context.Respond(&proto.EditResponse{
+            Success: false,
+            Message: "Feedback not found",
+        })
+        return
+    }
+
+    forum.mutex.Lock()
+    defer forum.mutex.Unlock()
+
+    if feedback.Creator != msg.UserHandle {
+        context.Respond(&proto.EditResponse{
+            Success: false,
+            Message: "Only the author can delete feedback",
+        })
+        return
+    }
+
+    if feedback.IsDeleted {
+        context.Respond(&proto.EditResponse{
+            Success: false,
+            Message: "Feedback already deleted",
+        })
+        return
+    }
+
+    // Replies hang off this node, so it becomes a tombstone instead of
+    // being unlinked from the tree
+    feedback.IsDeleted = true
+    feedback.Body = deletedBody
+    feedback.Revisions = nil
+    feedback.EditedAt = s.now(context).Unix()
+    s.persistFeedback(feedback)
+
+    log.Printf("Feedback %s deleted by %s", msg.FeedbackId, msg.UserHandle)
+    context.Respond(&proto.EditResponse{
+        Success: true,
+        Message: "Feedback deleted successfully",
+    })
+}
+
+// lastChange returns when an item was last written
+func lastChange(created, edited int64) int64 {
+    if edited > 0 {
+        return edited
+    }
+    return created
+}
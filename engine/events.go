@@ -0,0 +1,270 @@
+// engine/events.go
+package engine
+
+import (
+    "log"
+    "sync"
+    "github.com/asynkron/protoactor-go/actor"
+    protobuf "google.golang.org/protobuf/proto"
+    "reddit/proto"
+)
+
+// Kinds of events pushed to subscribers
+const (
+    EventPost    = "post"
+    EventComment = "comment"
+    EventVote    = "vote"
+    EventMessage = "message"
+    EventRead    = "read"
+
+    EventNotification = "notification"
+)
+
+// SubscriberBuffer is how many events a slow client may fall behind before
+// further events are dropped
+const SubscriberBuffer = 64
+
+// Subscriber is the actor the engine pushes events to on behalf of one
+// client connection. It hands them over on a channel, and forwards Subscribe
+// and Unsubscribe so that the engine sees it as the sender.
+type Subscriber struct {
+    engine *actor.PID
+    user   string
+    posts  []string
+    events chan *proto.Event
+}
+
+// NewSubscriber returns the props of a subscriber for user that also watches
+// posts, and the channel it delivers events on
+func NewSubscriber(engine *actor.PID, user string, posts []string) (*actor.Props, <-chan *proto.Event) {
+    events := make(chan *proto.Event, SubscriberBuffer)
+    return actor.PropsFromProducer(func() actor.Actor {
+        return &Subscriber{
+            engine: engine,
+            user:   user,
+            posts:  posts,
+            events: events,
+        }
+    }), events
+}
+
+func (a *Subscriber) Receive(context actor.Context) {
+    switch msg := context.Message().(type) {
+    case *actor.Started:
+        context.Request(a.engine, &proto.Subscribe{
+            UserHandle: a.user,
+            ContentIds: a.posts,
+        })
+    case *proto.Subscribe, *proto.Unsubscribe:
+        context.Request(a.engine, msg)
+    case *proto.Event:
+        select {
+        case a.events <- msg:
+        default:
+            log.Printf("Dropping %s event for slow subscriber %s", msg.Kind, a.user)
+        }
+    }
+}
+
+// subscription is a subscriber actor listening on behalf of a user. It
+// receives posts in the user's forums, messages and notifications for the
+// user and activity on the posts it watches. The watch list is replaced
+// rather than changed, so a copy of the subscription can be read without
+// the list's lock.
+type subscription struct {
+    pid      *actor.PID
+    user     string
+    contents map[string]bool
+}
+
+// subscriberList holds the subscriptions. Only the engine actor changes it,
+// but shards publish to it, so it has a lock of its own.
+type subscriberList struct {
+    mutex sync.RWMutex
+    subs  map[string]*subscription
+}
+
+func newSubscriberList() *subscriberList {
+    return &subscriberList{subs: make(map[string]*subscription)}
+}
+
+// copies returns a copy of every subscription
+func (l *subscriberList) copies() []subscription {
+    l.mutex.RLock()
+    defer l.mutex.RUnlock()
+
+    subs := make([]subscription, 0, len(l.subs))
+    for _, sub := range l.subs {
+        subs = append(subs, *sub)
+    }
+    return subs
+}
+
+// handleSubscribe registers the sending actor as a subscriber, or adds posts
+// to its watch list. Subscriptions live only as long as the subscriber and
+// are not journaled.
+func (s *SocialEngine) handleSubscribe(context actor.Context, msg *proto.Subscribe) {
+    sender := context.Sender()
+    if sender == nil {
+        return
+    }
+
+    s.subscribers.mutex.RLock()
+    sub, exists := s.subscribers.subs[sender.Id]
+    s.subscribers.mutex.RUnlock()
+
+    user := msg.UserHandle
+    if exists {
+        user = sub.user
+    } else if _, exists := s.users.get(user); !exists {
+        return
+    }
+
+    // Posts are checked before the list is locked, so that no forum is
+    // locked while it is
+    var watched []string
+    for _, contentId := range msg.ContentIds {
+        if content, forum, exists := s.lookupContent(contentId); exists {
+            forum.mutex.RLock()
+            visible := s.canViewContent(content, user)
+            forum.mutex.RUnlock()
+            if !visible {
+                continue
+            }
+        }
+        watched = append(watched, contentId)
+    }
+
+    s.subscribers.mutex.Lock()
+    defer s.subscribers.mutex.Unlock()
+
+    contents := make(map[string]bool)
+    if exists {
+        for contentId := range sub.contents {
+            contents[contentId] = true
+        }
+    }
+    for _, contentId := range watched {
+        contents[contentId] = true
+    }
+    s.subscribers.subs[sender.Id] = &subscription{
+        pid:      sender,
+        user:     user,
+        contents: contents,
+    }
+    if !exists {
+        context.Watch(sender)
+        log.Printf("User %s subscribed to events", user)
+    }
+}
+
+func (s *SocialEngine) handleUnsubscribe(context actor.Context, msg *proto.Unsubscribe) {
+    sender := context.Sender()
+    if sender == nil {
+        return
+    }
+
+    s.subscribers.mutex.Lock()
+    defer s.subscribers.mutex.Unlock()
+
+    if sub, exists := s.subscribers.subs[sender.Id]; exists {
+        contents := make(map[string]bool, len(sub.contents))
+        for contentId := range sub.contents {
+            contents[contentId] = true
+        }
+        for _, contentId := range msg.ContentIds {
+            delete(contents, contentId)
+        }
+        s.subscribers.subs[sender.Id] = &subscription{
+            pid:      sub.pid,
+            user:     sub.user,
+            contents: contents,
+        }
+    }
+}
+
+// handleSubscriberTerminated drops the subscription of a stopped subscriber
+func (s *SocialEngine) handleSubscriberTerminated(msg *actor.Terminated) {
+    s.subscribers.mutex.Lock()
+    defer s.subscribers.mutex.Unlock()
+
+    if sub, exists := s.subscribers.subs[msg.Who.Id]; exists {
+        delete(s.subscribers.subs, msg.Who.Id)
+        log.Printf("User %s unsubscribed from events", sub.user)
+    }
+}
+
+// publish sends an event to every subscriber that wants it. Callers pass
+// copies of live items, since the event is read after their locks are
+// released. The subscriptions are copied out first, so wants may take the
+// lock of a user.
+func (s *SocialEngine) publish(context actor.Context, event *proto.Event, wants func(*subscription) bool) {
+    subs := s.subscribers.copies()
+    if len(subs) == 0 {
+        return
+    }
+
+    event.Timestamp = s.now(context).Unix()
+    for i := range subs {
+        if wants(&subs[i]) {
+            context.Send(subs[i].pid, event)
+        }
+    }
+}
+
+func (s *SocialEngine) publishContent(context actor.Context, content *proto.Content) {
+    s.publish(context, &proto.Event{
+        Kind:    EventPost,
+        Content: protobuf.Clone(content).(*proto.Content),
+    }, func(sub *subscription) bool {
+        user, exists := s.users.get(sub.user)
+        if !exists {
+            return false
+        }
+        user.mutex.RLock()
+        defer user.mutex.RUnlock()
+
+        return user.Forums[content.Subreddit]
+    })
+}
+
+func (s *SocialEngine) publishFeedback(context actor.Context, feedback *proto.Feedback) {
+    s.publish(context, &proto.Event{
+        Kind:     EventComment,
+        Feedback: protobuf.Clone(feedback).(*proto.Feedback),
+    }, func(sub *subscription) bool {
+        return sub.contents[feedback.ContentId]
+    })
+}
+
+// publishVote reports the new score of a post or comment to subscribers
+// watching the post it belongs to
+func (s *SocialEngine) publishVote(context actor.Context, itemId string, contentId string, isContent bool, points int32) {
+    s.publish(context, &proto.Event{
+        Kind:      EventVote,
+        ItemId:    itemId,
+        IsContent: isContent,
+        Points:    points,
+    }, func(sub *subscription) bool {
+        return sub.contents[contentId]
+    })
+}
+
+func (s *SocialEngine) publishChat(context actor.Context, chat *proto.DirectChat) {
+    s.publish(context, &proto.Event{
+        Kind: EventMessage,
+        Chat: protobuf.Clone(chat).(*proto.DirectChat),
+    }, func(sub *subscription) bool {
+        return sub.user == chat.Receiver
+    })
+}
+
+// publishRead sends a read receipt to the sender of a message
+func (s *SocialEngine) publishRead(context actor.Context, chat *proto.DirectChat) {
+    s.publish(context, &proto.Event{
+        Kind: EventRead,
+        Chat: protobuf.Clone(chat).(*proto.DirectChat),
+    }, func(sub *subscription) bool {
+        return sub.user == chat.Sender
+    })
+}
@@ -0,0 +1,283 @@
+// engine/forum_access.go
+package engine
+
+import (
+    "log"
+    "sort"
+    "github.com/asynkron/protoactor-go/actor"
+    "reddit/proto"
+)
+
+// canView reports whether a user may read the forum's posts and comments.
+// Private forums are only readable by their members; an empty handle is an
+// anonymous reader.
+func (f *ForumData) canView(handle string) bool {
+    if f.Visibility != VisibilityPrivate {
+        return true
+    }
+    return handle != "" && !f.isBanned(handle) && (f.Members[handle] || f.canModerate(handle))
+}
+
+// canPost reports whether a user may submit posts to the forum. Restricted
+// forums only accept posts from approved users.
+func (f *ForumData) canPost(handle string) bool {
+    switch f.Visibility {
+    case VisibilityPrivate:
+        return f.canView(handle)
+    case VisibilityRestricted:
+        return f.Approved[handle] || f.canModerate(handle)
+    }
+    return true
+}
+
+// approve lets a user into the forum, settling any invitation or request.
+// Callers add the forum to the user's list with setMembership.
+func (f *ForumData) approve(handle string) {
+    f.Approved[handle] = true
+    delete(f.Invited, handle)
+    delete(f.JoinRequests, handle)
+    f.Members[handle] = true
+}
+
+// revokeAccess forgets everything that would let a user back into the forum
+func (f *ForumData) revokeAccess(handle string) {
+    delete(f.Approved, handle)
+    delete(f.Invited, handle)
+    delete(f.JoinRequests, handle)
+}
+
+func (f *ForumData) joinRequestList() []*proto.JoinRequest {
+    requests := make([]*proto.JoinRequest, 0, len(f.JoinRequests))
+    for _, request := range f.JoinRequests {
+        requests = append(requests, request)
+    }
+    sort.Slice(requests, func(i, j int) bool {
+        if requests[i].RequestedAt != requests[j].RequestedAt {
+            return requests[i].RequestedAt < requests[j].RequestedAt
+        }
+        return requests[i].UserHandle < requests[j].UserHandle
+    })
+    return requests
+}
+
+func sortedHandles(handles map[string]bool) []string {
+    list := make([]string, 0, len(handles))
+    for handle := range handles {
+        list = append(list, handle)
+    }
+    sort.Strings(list)
+    return list
+}
+
+// canViewContent reports whether a user may read a post and its comments.
+// Callers hold the lock of the post's forum.
+func (s *SocialEngine) canViewContent(content *proto.Content, handle string) bool {
+    forum, exists := s.forums.get(content.Subreddit)
+    return !exists || forum.canView(handle)
+}
+
+func (s *SocialEngine) handleInviteToForum(context actor.Context, msg *proto.InviteToForum) {
+    forum, exists := s.forums.get(msg.Subreddit)
+    if !exists {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "Forum not found",
+        })
+        return
+    }
+
+    forum.mutex.Lock()
+    defer forum.mutex.Unlock()
+
+    if !forum.canModerate(msg.UserHandle) {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "Only moderators can invite users",
+        })
+        return
+    }
+
+    if _, exists := s.users.get(msg.Target); !exists {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "User not found",
+        })
+        return
+    }
+
+    switch {
+    case forum.isBanned(msg.Target):
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "User is banned from this forum",
+        })
+        return
+    case forum.Approved[msg.Target] || forum.canModerate(msg.Target):
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "User is already approved",
+        })
+        return
+    }
+
+    forum.Invited[msg.Target] = true
+    s.persistForum(forum)
+
+    log.Printf("User %s invited %s to %s", msg.UserHandle, msg.Target, msg.Subreddit)
+    context.Respond(&proto.ModerationResponse{
+        Success: true,
+        Message: "User invited successfully",
+    })
+}
+
+func (s *SocialEngine) handleRequestToJoin(context actor.Context, msg *proto.RequestToJoin) {
+    if _, exists := s.users.get(msg.UserHandle); !exists {
+        context.Respond(&proto.JoinForumResponse{
+            Success: false,
+            Message: "User not found",
+        })
+        return
+    }
+
+    forum, exists := s.forums.get(msg.Subreddit)
+    if !exists {
+        context.Respond(&proto.JoinForumResponse{
+            Success: false,
+            Message: "Forum not found",
+        })
+        return
+    }
+
+    forum.mutex.Lock()
+    defer forum.mutex.Unlock()
+
+    switch {
+    case forum.Visibility == VisibilityPublic:
+        context.Respond(&proto.JoinForumResponse{
+            Success: false,
+            Message: "Anyone can join this forum",
+        })
+        return
+    case forum.isBanned(msg.UserHandle):
+        context.Respond(&proto.JoinForumResponse{
+            Success: false,
+            Message: "User is banned from this forum",
+        })
+        return
+    case forum.Approved[msg.UserHandle] || forum.canModerate(msg.UserHandle):
+        context.Respond(&proto.JoinForumResponse{
+            Success: false,
+            Message: "User is already approved",
+        })
+        return
+    case forum.JoinRequests[msg.UserHandle] != nil:
+        context.Respond(&proto.JoinForumResponse{
+            Success: false,
+            Message: "Join request already pending",
+        })
+        return
+    }
+
+    forum.JoinRequests[msg.UserHandle] = &proto.JoinRequest{
+        UserHandle:  msg.UserHandle,
+        Message:     msg.Message,
+        RequestedAt: s.now(context).Unix(),
+    }
+    s.persistForum(forum)
+
+    log.Printf("User %s asked to join %s", msg.UserHandle, msg.Subreddit)
+    context.Respond(&proto.JoinForumResponse{
+        Success: true,
+        Message: "Join request sent",
+    })
+}
+
+func (s *SocialEngine) handleReviewJoinRequest(context actor.Context, msg *proto.ReviewJoinRequest) {
+    forum, exists := s.forums.get(msg.Subreddit)
+    if !exists {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "Forum not found",
+        })
+        return
+    }
+
+    forum.mutex.Lock()
+    defer forum.mutex.Unlock()
+
+    if !forum.canModerate(msg.UserHandle) {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "Only moderators can review join requests",
+        })
+        return
+    }
+
+    if forum.JoinRequests[msg.Target] == nil {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "No pending request from this user",
+        })
+        return
+    }
+
+    if !msg.Approve {
+        delete(forum.JoinRequests, msg.Target)
+        s.persistForum(forum)
+
+        log.Printf("User %s declined %s's request to join %s", msg.UserHandle, msg.Target, msg.Subreddit)
+        context.Respond(&proto.ModerationResponse{
+            Success: true,
+            Message: "Join request declined",
+        })
+        return
+    }
+
+    target, exists := s.users.get(msg.Target)
+    if !exists {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "User not found",
+        })
+        return
+    }
+
+    forum.approve(msg.Target)
+    s.setMembership(target, msg.Subreddit, true)
+    s.persistForum(forum)
+
+    log.Printf("User %s approved %s's request to join %s", msg.UserHandle, msg.Target, msg.Subreddit)
+    context.Respond(&proto.ModerationResponse{
+        Success: true,
+        Message: "Join request approved",
+    })
+}
+
+func (s *SocialEngine) handleGetJoinRequests(context actor.Context, msg *proto.GetJoinRequests) {
+    forum, exists := s.forums.get(msg.Subreddit)
+    if !exists {
+        context.Respond(&proto.JoinRequestList{
+            Success: false,
+            Message: "Forum not found",
+        })
+        return
+    }
+
+    forum.mutex.RLock()
+    defer forum.mutex.RUnlock()
+
+    if !forum.canModerate(msg.UserHandle) {
+        context.Respond(&proto.JoinRequestList{
+            Success: false,
+            Message: "Only moderators can view join requests",
+        })
+        return
+    }
+
+    context.Respond(&proto.JoinRequestList{
+        Success:  true,
+        Message:  "Join requests retrieved successfully",
+        Requests: forum.joinRequestList(),
+        Invited:  sortedHandles(forum.Invited),
+    })
+}
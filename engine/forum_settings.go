@@ -0,0 +1,144 @@
+// engine/forum_settings.go
+package engine
+
+import (
+    "fmt"
+    "log"
+    "github.com/asynkron/protoactor-go/actor"
+    "reddit/proto"
+)
+
+// Forum visibilities
+const (
+    VisibilityPublic     = "public"
+    VisibilityRestricted = "restricted"
+    VisibilityPrivate    = "private"
+)
+
+const (
+    maxDescriptionLength = 500
+    maxRules             = 15
+    maxRuleLength        = 100
+)
+
+// validateForumSettings returns a message explaining why the settings cannot
+// be used, or an empty string
+func validateForumSettings(settings *proto.ForumSettings) string {
+    if settings == nil {
+        return ""
+    }
+    switch settings.Visibility {
+    case "", VisibilityPublic, VisibilityRestricted, VisibilityPrivate:
+    default:
+        return "Visibility must be public, restricted or private"
+    }
+    if len(settings.Description) > maxDescriptionLength {
+        return fmt.Sprintf("Description cannot be longer than %d characters", maxDescriptionLength)
+    }
+    if len(settings.Rules) > maxRules {
+        return fmt.Sprintf("A forum cannot have more than %d rules", maxRules)
+    }
+    for _, rule := range settings.Rules {
+        if rule == "" {
+            return "Rules cannot be empty"
+        }
+        if len(rule) > maxRuleLength {
+            return fmt.Sprintf("Rules cannot be longer than %d characters", maxRuleLength)
+        }
+    }
+    if settings.MinKarma < 0 {
+        return "Minimum karma cannot be negative"
+    }
+    return ""
+}
+
+// applySettings copies settings onto the forum. Missing settings leave a
+// public forum open to every kind of post.
+func (f *ForumData) applySettings(settings *proto.ForumSettings) {
+    if settings == nil {
+        settings = &proto.ForumSettings{}
+    }
+    f.Description = settings.Description
+    f.Rules = append([]string(nil), settings.Rules...)
+    f.Visibility = settings.Visibility
+    if f.Visibility == "" {
+        f.Visibility = VisibilityPublic
+    }
+    f.TextOnly = settings.TextOnly
+    f.LinksDisabled = settings.LinksDisabled
+    f.MinKarma = int(settings.MinKarma)
+    f.ExcludeFromPopular = settings.ExcludeFromPopular
+}
+
+func (f *ForumData) settings() *proto.ForumSettings {
+    return &proto.ForumSettings{
+        Description:        f.Description,
+        Rules:              append([]string(nil), f.Rules...),
+        Visibility:         f.Visibility,
+        TextOnly:           f.TextOnly,
+        LinksDisabled:      f.LinksDisabled,
+        MinKarma:           int32(f.MinKarma),
+        ExcludeFromPopular: f.ExcludeFromPopular,
+    }
+}
+
+// postingProblem checks a new post of the given kind against the forum's
+// access and posting settings. Moderators are exempt from the karma
+// requirement so a new forum's owner is never locked out of it.
+func (f *ForumData) postingProblem(user *UserData, kind string) string {
+    if !f.canPost(user.Handle) {
+        if f.Visibility == VisibilityPrivate {
+            return "Only members can post in this forum"
+        }
+        return "Only approved users can post in this forum"
+    }
+    if f.TextOnly && kind != PostText {
+        return "This forum only allows text posts"
+    }
+    if f.LinksDisabled && kind == PostLink {
+        return "This forum does not allow link posts"
+    }
+    if f.MinKarma > 0 && !f.canModerate(user.Handle) && user.currentKarma() < f.MinKarma {
+        return fmt.Sprintf("Posting in this forum requires %d karma", f.MinKarma)
+    }
+    return ""
+}
+
+func (s *SocialEngine) handleUpdateForumSettings(context actor.Context, msg *proto.UpdateForumSettings) {
+    forum, exists := s.forums.get(msg.Subreddit)
+    if !exists {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "Forum not found",
+        })
+        return
+    }
+
+    forum.mutex.Lock()
+    defer forum.mutex.Unlock()
+
+    if forum.Role(msg.UserHandle) != RoleOwner {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "Only the forum owner can change its settings",
+        })
+        return
+    }
+
+    if problem := validateForumSettings(msg.Settings); problem != "" {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: problem,
+        })
+        return
+    }
+
+    forum.applySettings(msg.Settings)
+    s.persistForum(forum)
+
+    log.Printf("User %s updated the settings of %s", msg.UserHandle, msg.Subreddit)
+    context.Respond(&proto.ModerationResponse{
+        Success: true,
+        Message: "Forum settings updated",
+    })
+}
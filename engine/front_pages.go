@@ -0,0 +1,85 @@
+// engine/front_pages.go
+package engine
+
+import (
+    "github.com/asynkron/protoactor-go/actor"
+    "reddit/proto"
+)
+
+// Site-wide listings. All lists every forum anyone can read; popular only
+// lists posts that have drawn enough upvotes and comments, and leaves out
+// forums that have opted out of it.
+const (
+    FrontAll     = "all"
+    FrontPopular = "popular"
+)
+
+// popularEngagement is how many upvotes and comments together a post needs
+// to be listed on popular
+const popularEngagement = 5
+
+// onFrontPage reports whether a forum's posts appear on the given page
+func (f *ForumData) onFrontPage(page string) bool {
+    if f.Visibility == VisibilityPrivate {
+        return false
+    }
+    return page != FrontPopular || !f.ExcludeFromPopular
+}
+
+// isPopular reports whether a post has drawn enough engagement for the
+// popular page. Callers hold the lock of the post's forum.
+func (s *SocialEngine) isPopular(content *proto.Content) bool {
+    score, _ := s.scores.get(content.ContentId)
+    return int32(score.Ups)+threadSize(content.Feedback) >= popularEngagement
+}
+
+func (s *SocialEngine) handleGetFrontPage(context actor.Context, msg *proto.GetFrontPage) {
+    if msg.Page != FrontAll && msg.Page != FrontPopular {
+        context.Respond(&proto.FeedBundle{
+            Success: false,
+            Message: "Unknown front page",
+        })
+        return
+    }
+
+    posts, err := s.ranking(msg.SortMethod, msg.Period, rankingTime(msg.After, msg.Before))
+    if err != nil {
+        context.Respond(&proto.FeedBundle{
+            Success: false,
+            Message: rankingProblem(err),
+        })
+        return
+    }
+
+    // Each forum is read under its own lock, so the page is a view of every
+    // forum at a slightly different moment
+    listed := posts.gather()
+    for _, forum := range s.forums.all() {
+        forum.mutex.RLock()
+        if forum.onFrontPage(msg.Page) {
+            for _, content := range forum.Contents {
+                if msg.Page != FrontPopular || s.isPopular(content) {
+                    listed.add(content)
+                }
+            }
+        }
+        forum.mutex.RUnlock()
+    }
+
+    result, err := listed.paginate(msg.After, msg.Before, msg.Limit)
+    if err != nil {
+        context.Respond(&proto.FeedBundle{
+            Success: false,
+            Message: "Invalid cursor",
+        })
+        return
+    }
+
+    context.Respond(&proto.FeedBundle{
+        Success:    true,
+        Message:    "Posts retrieved successfully",
+        Contents:   s.shownPosts(result.items),
+        NextCursor: result.next,
+        PrevCursor: result.prev,
+    })
+}
@@ -0,0 +1,216 @@
+// engine/journal.go
+package engine
+
+import (
+    "crypto/rand"
+    "fmt"
+    "log"
+    "time"
+    "github.com/asynkron/protoactor-go/actor"
+    "google.golang.org/protobuf/reflect/protoreflect"
+    "google.golang.org/protobuf/types/known/anypb"
+    "reddit/proto"
+    "reddit/utils"
+)
+
+// isCommand reports whether msg changes engine state and therefore has to be
+// journaled before it is handled. Queries are left out of the journal.
+func isCommand(msg interface{}) bool {
+    switch msg.(type) {
+    case *proto.OnboardUser, *proto.ActivityStatus,
+        *proto.CreateForum, *proto.JoinForum, *proto.LeaveForum, *proto.UpdateForumSettings,
+        *proto.CreateContent, *proto.CreateFeedback, *proto.Reaction,
+        *proto.DirectChat, *proto.MarkRead, *proto.MarkNotificationsRead,
+        *proto.AppointModerator, *proto.RemoveModerator, *proto.BanUser, *proto.UnbanUser,
+        *proto.RemoveContent, *proto.RemoveFeedback, *proto.LockContent,
+        *proto.ClearVoteFlag, *proto.FlagVoter,
+        *proto.InviteToForum, *proto.RequestToJoin, *proto.ReviewJoinRequest,
+        *proto.EditContent, *proto.DeleteContent, *proto.EditFeedback, *proto.DeleteFeedback:
+        return true
+    }
+    return false
+}
+
+// record appends msg to the journal before it is applied and returns its
+// entry. The entry pins the clock and the seed for generated IDs, so
+// replaying it produces exactly the same state. It only runs on the engine
+// actor, which numbers the entries.
+func (s *SocialEngine) record(msg interface{}) *proto.JournalEntry {
+    seed := make([]byte, 8)
+    if _, err := rand.Read(seed); err != nil {
+        log.Fatalf("Failed to generate journal seed: %v", err)
+    }
+
+    entry := &proto.JournalEntry{
+        Timestamp: time.Now().UnixNano(),
+        Seed:      seed,
+    }
+    command, err := anypb.New(msg.(protoreflect.ProtoMessage))
+    if err != nil {
+        log.Printf("Failed to encode command for journal: %v", err)
+        return entry
+    }
+
+    s.sequence++
+    entry.Sequence = s.sequence
+    entry.Command = command
+    if err := s.journal.Append(entry); err != nil {
+        log.Printf("Failed to append journal entry %d: %v", entry.Sequence, err)
+    }
+    return entry
+}
+
+// commandContext is the context a journaled command is handled in. It
+// carries the command's entry, so that commands applied on different shards
+// at the same time each read their own clock and ID seed.
+type commandContext struct {
+    actor.Context
+    entry    *proto.JournalEntry
+    derived  int
+    replayed bool
+}
+
+// silentContext drops responses. Replicas and journal replays apply commands
+// only to rebuild state; nobody is waiting for the answer. A replica wraps
+// its own context, so events still reach its subscribers. A journal replayed
+// at startup has no actor to wrap, and then messages the command sends are
+// dropped too.
+type silentContext struct {
+    actor.Context
+}
+
+func (silentContext) Respond(response interface{}) {}
+
+// Sender is nil: the command's sender is not waiting for anything
+func (silentContext) Sender() *actor.PID {
+    return nil
+}
+
+func (c silentContext) Self() *actor.PID {
+    if c.Context == nil {
+        return nil
+    }
+    return c.Context.Self()
+}
+
+func (c silentContext) Message() interface{} {
+    if c.Context == nil {
+        return nil
+    }
+    return c.Context.Message()
+}
+
+func (c silentContext) Send(pid *actor.PID, message interface{}) {
+    if c.Context != nil {
+        c.Context.Send(pid, message)
+    }
+}
+
+func (c silentContext) Request(pid *actor.PID, message interface{}) {
+    if c.Context != nil {
+        c.Context.Request(pid, message)
+    }
+}
+
+func (c silentContext) RequestWithCustomSender(pid *actor.PID, message interface{}, sender *actor.PID) {
+    if c.Context != nil {
+        c.Context.RequestWithCustomSender(pid, message, sender)
+    }
+}
+
+// Forward does nothing, since the command has been answered already
+func (silentContext) Forward(pid *actor.PID) {}
+
+func (c silentContext) Watch(pid *actor.PID) {
+    if c.Context != nil {
+        c.Context.Watch(pid)
+    }
+}
+
+func (c silentContext) Unwatch(pid *actor.PID) {
+    if c.Context != nil {
+        c.Context.Unwatch(pid)
+    }
+}
+
+// Replay rebuilds the engine state from a journal captured elsewhere,
+// journaling each entry again so the engine's own journal covers the state.
+// It must be called on an empty engine before the engine actor is spawned.
+func (s *SocialEngine) Replay(entries []*proto.JournalEntry) error {
+    for _, entry := range entries {
+        msg, err := entry.Command.UnmarshalNew()
+        if err != nil {
+            return fmt.Errorf("failed to decode journal entry %d: %v", entry.Sequence, err)
+        }
+        if err := s.journal.Append(entry); err != nil {
+            return fmt.Errorf("failed to journal entry %d: %v", entry.Sequence, err)
+        }
+        s.apply(silentContext{}, entry, msg)
+        if s.compactDue.CompareAndSwap(true, false) {
+            s.snapshot()
+        }
+    }
+    return nil
+}
+
+// replay applies a journal entry from the engine exactly as it was applied
+// originally. The entry is not journaled again.
+func (s *SocialEngine) replay(context actor.Context, entry *proto.JournalEntry) {
+    msg, err := entry.Command.UnmarshalNew()
+    if err != nil {
+        log.Printf("Skipping journal entry %d: %v", entry.Sequence, err)
+        return
+    }
+    s.apply(context, entry, msg)
+}
+
+// apply handles a journaled command again, one at a time and in journal
+// order. Only the engine actor, or a replay before it is spawned, applies
+// entries this way.
+func (s *SocialEngine) apply(context actor.Context, entry *proto.JournalEntry, msg interface{}) {
+    if entry.Sequence > s.sequence {
+        s.sequence = entry.Sequence
+    }
+    s.handle(context, entry, msg, true)
+}
+
+// handle applies a journaled command with the clock and ID seed it was
+// originally handled with. A replayed command leaves out what the original
+// passed on to the engine as commands of their own.
+func (s *SocialEngine) handle(context actor.Context, entry *proto.JournalEntry, msg interface{}, replayed bool) {
+    s.dispatch(&commandContext{Context: context, entry: entry, replayed: replayed}, msg)
+}
+
+// replayed reports whether the command being handled is applied again from
+// the journal rather than for the first time
+func replayed(context actor.Context) bool {
+    command, ok := context.(*commandContext)
+    return ok && command.replayed
+}
+
+// now returns the time of the command being handled, falling back to the
+// wall clock for queries.
+func (s *SocialEngine) now(context actor.Context) time.Time {
+    if command, ok := context.(*commandContext); ok {
+        return time.Unix(0, command.entry.Timestamp)
+    }
+    return time.Now()
+}
+
+// newID derives IDs from the seed of the command being handled.
+func (s *SocialEngine) newID(context actor.Context, prefix string) string {
+    command, ok := context.(*commandContext)
+    if !ok {
+        return utils.GenerateID(prefix)
+    }
+    command.derived++
+    return utils.DeriveID(prefix, command.entry.Seed, command.derived)
+}
+
+// commandSequence is the journal sequence of the command being handled
+func commandSequence(context actor.Context) int64 {
+    if command, ok := context.(*commandContext); ok {
+        return command.entry.Sequence
+    }
+    return 0
+}
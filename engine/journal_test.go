@@ -0,0 +1,193 @@
+// engine/journal_test.go
+package engine
+
+import (
+    "testing"
+    "time"
+    "github.com/asynkron/protoactor-go/actor"
+    "google.golang.org/protobuf/types/known/anypb"
+    "reddit/proto"
+    "reddit/storage"
+)
+
+// testContext stands in for an actor context, keeping what a handler
+// responds and sends
+type testContext struct {
+    actor.Context
+    responses []interface{}
+    sent      []interface{}
+}
+
+func (c *testContext) Respond(response interface{}) {
+    c.responses = append(c.responses, response)
+}
+
+func (c *testContext) Send(pid *actor.PID, message interface{}) {
+    c.sent = append(c.sent, message)
+}
+
+func (c *testContext) Sender() *actor.PID { return nil }
+func (c *testContext) Self() *actor.PID   { return nil }
+
+func newTestEngine() *SocialEngine {
+    return NewSocialEngine(storage.NewNopStore(), storage.NewNopJournal())
+}
+
+// run journals and handles a command the way the engine actor does,
+// returning the response and whatever the command sent on
+func run(s *SocialEngine, msg interface{}) (interface{}, []interface{}) {
+    context := &testContext{}
+    s.handle(context, s.record(msg), msg, false)
+    var response interface{}
+    if len(context.responses) > 0 {
+        response = context.responses[len(context.responses)-1]
+    }
+    return response, context.sent
+}
+
+// query handles a message that is not journaled and returns its response
+func query(s *SocialEngine, msg interface{}) interface{} {
+    context := &testContext{}
+    s.dispatch(context, msg)
+    if len(context.responses) == 0 {
+        return nil
+    }
+    return context.responses[len(context.responses)-1]
+}
+
+func TestCommandContextIsDeterministic(t *testing.T) {
+    s := newTestEngine()
+    at := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+    entry := &proto.JournalEntry{Sequence: 7, Timestamp: at.UnixNano(), Seed: []byte("seed0001")}
+
+    first := &commandContext{entry: entry}
+    second := &commandContext{entry: entry, replayed: true}
+
+    if got := s.now(first); !got.Equal(at) {
+        t.Errorf("now = %v, want %v", got, at)
+    }
+    if got := commandSequence(first); got != 7 {
+        t.Errorf("commandSequence = %d, want 7", got)
+    }
+    if replayed(first) || !replayed(second) {
+        t.Errorf("replayed = %v, %v, want false, true", replayed(first), replayed(second))
+    }
+
+    a1, a2 := s.newID(first, "cnt"), s.newID(first, "cnt")
+    b1, b2 := s.newID(second, "cnt"), s.newID(second, "cnt")
+    if a1 != b1 || a2 != b2 {
+        t.Errorf("IDs differ between handlings: %s %s and %s %s", a1, a2, b1, b2)
+    }
+    if a1 == a2 {
+        t.Errorf("one command derived the same ID twice: %s", a1)
+    }
+}
+
+func TestQueriesFallBackToWallClock(t *testing.T) {
+    s := newTestEngine()
+    context := &testContext{}
+
+    before := time.Now()
+    if got := s.now(context); got.Before(before) {
+        t.Errorf("now = %v, want at least %v", got, before)
+    }
+    if got := commandSequence(context); got != 0 {
+        t.Errorf("commandSequence = %d, want 0", got)
+    }
+    if replayed(context) {
+        t.Error("a query counts as replayed")
+    }
+    if s.newID(context, "cnt") == s.newID(context, "cnt") {
+        t.Error("queries derived the same ID twice")
+    }
+}
+
+func TestSilentContextWithoutActor(t *testing.T) {
+    context := silentContext{}
+
+    // None of these may reach the missing actor context
+    context.Respond(&proto.OnboardUserResponse{})
+    context.Send(nil, &proto.Event{})
+    context.Request(nil, &proto.Event{})
+    context.Forward(nil)
+    context.Watch(nil)
+    context.Unwatch(nil)
+    if context.Sender() != nil || context.Self() != nil || context.Message() != nil {
+        t.Error("silent context without an actor reports one")
+    }
+}
+
+func TestSilentContextDropsResponses(t *testing.T) {
+    inner := &testContext{}
+    context := silentContext{Context: inner}
+
+    context.Respond(&proto.OnboardUserResponse{})
+    context.Send(nil, &proto.Event{})
+    if len(inner.responses) != 0 {
+        t.Errorf("responses reached the wrapped context: %v", inner.responses)
+    }
+    if len(inner.sent) != 1 {
+        t.Errorf("sent %d messages through the wrapped context, want 1", len(inner.sent))
+    }
+}
+
+// memoryJournal keeps the entries it is given
+type memoryJournal struct {
+    entries []*proto.JournalEntry
+}
+
+func (j *memoryJournal) Append(entry *proto.JournalEntry) error {
+    j.entries = append(j.entries, entry)
+    return nil
+}
+
+func (j *memoryJournal) ReadAll() ([]*proto.JournalEntry, error) { return j.entries, nil }
+func (j *memoryJournal) LastSequence() int64                    { return int64(len(j.entries)) }
+func (j *memoryJournal) Close() error                           { return nil }
+
+func TestReplayRebuildsSameState(t *testing.T) {
+    journal := &memoryJournal{}
+    original := NewSocialEngine(storage.NewNopStore(), journal)
+    run(original, &proto.OnboardUser{UserHandle: "alice"})
+    run(original, &proto.CreateForum{Name: "golang", UserHandle: "alice"})
+    response, _ := run(original, &proto.CreateContent{UserHandle: "alice", Subreddit: "golang", Heading: "Generics", Body: "At last"})
+    created := response.(*proto.CreateContentResponse)
+    if !created.Success {
+        t.Fatalf("creating post: %s", created.Message)
+    }
+    // A duplicate signup is journaled but changes nothing
+    run(original, &proto.OnboardUser{UserHandle: "alice"})
+
+    replica := newTestEngine()
+    if err := replica.Replay(journal.entries); err != nil {
+        t.Fatalf("Replay: %v", err)
+    }
+    if replica.sequence != 4 {
+        t.Errorf("sequence = %d, want 4", replica.sequence)
+    }
+
+    want, _ := original.contents.get(created.ContentId)
+    got, exists := replica.contents.get(created.ContentId)
+    if !exists {
+        t.Fatalf("replay did not derive post ID %s", created.ContentId)
+    }
+    if got.Timestamp != want.Timestamp {
+        t.Errorf("post timestamp = %d after replay, want %d", got.Timestamp, want.Timestamp)
+    }
+    wantUser, _ := original.users.get("alice")
+    gotUser, _ := replica.users.get("alice")
+    if !gotUser.Created.Equal(wantUser.Created) || !gotUser.Forums["golang"] {
+        t.Errorf("alice after replay = %v %v, want %v with golang", gotUser.Created, gotUser.Forums, wantUser.Created)
+    }
+}
+
+func TestReplayRejectsUndecodableEntry(t *testing.T) {
+    s := newTestEngine()
+    entry := &proto.JournalEntry{
+        Sequence: 1,
+        Command:  &anypb.Any{TypeUrl: "type.googleapis.com/reddit.Unknown"},
+    }
+    if err := s.Replay([]*proto.JournalEntry{entry}); err == nil {
+        t.Error("Replay accepted an entry it cannot decode")
+    }
+}
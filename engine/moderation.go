@@ -0,0 +1,384 @@
+// engine/moderation.go
+package engine
+
+import (
+    "log"
+    "sort"
+    "github.com/asynkron/protoactor-go/actor"
+    "reddit/proto"
+)
+
+// Forum roles, from most to least privileged
+const (
+    RoleOwner     = "owner"
+    RoleModerator = "moderator"
+    RoleMember    = "member"
+    RoleBanned    = "banned"
+    RoleNone      = ""
+)
+
+const removedBody = "[removed]"
+
+// Role returns the role a user holds in the forum
+func (f *ForumData) Role(handle string) string {
+    switch {
+    case handle == f.Owner:
+        return RoleOwner
+    case f.Moderators[handle]:
+        return RoleModerator
+    case f.isBanned(handle):
+        return RoleBanned
+    case f.Members[handle]:
+        return RoleMember
+    }
+    return RoleNone
+}
+
+func (f *ForumData) isBanned(handle string) bool {
+    _, banned := f.Banned[handle]
+    return banned
+}
+
+func (f *ForumData) canModerate(handle string) bool {
+    role := f.Role(handle)
+    return role == RoleOwner || role == RoleModerator
+}
+
+func (f *ForumData) moderatorList() []string {
+    moderators := make([]string, 0, len(f.Moderators))
+    for moderator := range f.Moderators {
+        moderators = append(moderators, moderator)
+    }
+    sort.Strings(moderators)
+    return moderators
+}
+
+func (s *SocialEngine) handleAppointModerator(context actor.Context, msg *proto.AppointModerator) {
+    forum, exists := s.forums.get(msg.Subreddit)
+    if !exists {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "Forum not found",
+        })
+        return
+    }
+
+    forum.mutex.Lock()
+    defer forum.mutex.Unlock()
+
+    if forum.Role(msg.UserHandle) != RoleOwner {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "Only the forum owner can appoint moderators",
+        })
+        return
+    }
+
+    switch forum.Role(msg.Target) {
+    case RoleMember:
+    case RoleOwner, RoleModerator:
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "User is already a moderator",
+        })
+        return
+    default:
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "User is not a member",
+        })
+        return
+    }
+
+    forum.Moderators[msg.Target] = true
+    s.persistForum(forum)
+
+    log.Printf("User %s appointed %s as moderator of %s", msg.UserHandle, msg.Target, msg.Subreddit)
+    context.Respond(&proto.ModerationResponse{
+        Success: true,
+        Message: "Moderator appointed successfully",
+    })
+}
+
+func (s *SocialEngine) handleRemoveModerator(context actor.Context, msg *proto.RemoveModerator) {
+    forum, exists := s.forums.get(msg.Subreddit)
+    if !exists {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "Forum not found",
+        })
+        return
+    }
+
+    forum.mutex.Lock()
+    defer forum.mutex.Unlock()
+
+    // Moderators may step down themselves; only the owner removes others
+    if forum.Role(msg.UserHandle) != RoleOwner && msg.UserHandle != msg.Target {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "Only the forum owner can remove moderators",
+        })
+        return
+    }
+
+    if !forum.Moderators[msg.Target] {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "User is not a moderator",
+        })
+        return
+    }
+
+    delete(forum.Moderators, msg.Target)
+    s.persistForum(forum)
+
+    log.Printf("User %s removed %s as moderator of %s", msg.UserHandle, msg.Target, msg.Subreddit)
+    context.Respond(&proto.ModerationResponse{
+        Success: true,
+        Message: "Moderator removed successfully",
+    })
+}
+
+func (s *SocialEngine) handleBan(context actor.Context, msg *proto.BanUser) {
+    forum, exists := s.forums.get(msg.Subreddit)
+    if !exists {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "Forum not found",
+        })
+        return
+    }
+
+    forum.mutex.Lock()
+    defer forum.mutex.Unlock()
+
+    if !forum.canModerate(msg.UserHandle) {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "Only moderators can ban users",
+        })
+        return
+    }
+
+    target, exists := s.users.get(msg.Target)
+    if !exists {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "User not found",
+        })
+        return
+    }
+
+    switch forum.Role(msg.Target) {
+    case RoleOwner:
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "The forum owner cannot be banned",
+        })
+        return
+    case RoleModerator:
+        if forum.Role(msg.UserHandle) != RoleOwner {
+            context.Respond(&proto.ModerationResponse{
+                Success: false,
+                Message: "Only the forum owner can ban moderators",
+            })
+            return
+        }
+    case RoleBanned:
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "User is already banned",
+        })
+        return
+    }
+
+    reason := msg.Reason
+    if reason == "" {
+        reason = "No reason given"
+    }
+
+    forum.Banned[msg.Target] = reason
+    delete(forum.Moderators, msg.Target)
+    delete(forum.Members, msg.Target)
+    s.setMembership(target, msg.Subreddit, false)
+    forum.revokeAccess(msg.Target)
+    s.persistForum(forum)
+
+    log.Printf("User %s banned %s from %s: %s", msg.UserHandle, msg.Target, msg.Subreddit, reason)
+    context.Respond(&proto.ModerationResponse{
+        Success: true,
+        Message: "User banned successfully",
+    })
+}
+
+func (s *SocialEngine) handleUnban(context actor.Context, msg *proto.UnbanUser) {
+    forum, exists := s.forums.get(msg.Subreddit)
+    if !exists {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "Forum not found",
+        })
+        return
+    }
+
+    forum.mutex.Lock()
+    defer forum.mutex.Unlock()
+
+    if !forum.canModerate(msg.UserHandle) {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "Only moderators can unban users",
+        })
+        return
+    }
+
+    if !forum.isBanned(msg.Target) {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "User is not banned",
+        })
+        return
+    }
+
+    delete(forum.Banned, msg.Target)
+    s.persistForum(forum)
+
+    log.Printf("User %s unbanned %s from %s", msg.UserHandle, msg.Target, msg.Subreddit)
+    context.Respond(&proto.ModerationResponse{
+        Success: true,
+        Message: "User unbanned successfully",
+    })
+}
+
+// handleRemoveContent is applied by the engine rather than a shard, since a
+// removed post changes the shares and originals it is linked to
+func (s *SocialEngine) handleRemoveContent(context actor.Context, msg *proto.RemoveContent) {
+    content, forum, exists := s.lookupContent(msg.ContentId)
+    if !exists {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "Content not found",
+        })
+        return
+    }
+
+    forum.mutex.Lock()
+    defer forum.mutex.Unlock()
+
+    if !forum.canModerate(msg.UserHandle) {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "Only moderators can remove content",
+        })
+        return
+    }
+
+    if content.IsRemoved {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "Content already removed",
+        })
+        return
+    }
+
+    content.IsRemoved = true
+    content.RemovalReason = msg.Reason
+    content.Body = removedBody
+    content.Revisions = nil
+    s.persistContent(content)
+    if !content.IsDeleted {
+        s.contentGone(forum, content)
+    }
+    s.notifyRemoval(context, msg.UserHandle, content, nil, msg.Reason)
+
+    log.Printf("User %s removed content %s from %s", msg.UserHandle, msg.ContentId, content.Subreddit)
+    context.Respond(&proto.ModerationResponse{
+        Success: true,
+        Message: "Content removed successfully",
+    })
+}
+
+func (s *SocialEngine) handleRemoveFeedback(context actor.Context, msg *proto.RemoveFeedback) {
+    feedback, exists := s.feedbacks.get(msg.FeedbackId)
+    if !exists {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "Feedback not found",
+        })
+        return
+    }
+
+    content, forum, exists := s.lookupContent(feedback.ContentId)
+    if !exists {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "Content not found",
+        })
+        return
+    }
+
+    forum.mutex.Lock()
+    defer forum.mutex.Unlock()
+
+    if !forum.canModerate(msg.UserHandle) {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "Only moderators can remove feedback",
+        })
+        return
+    }
+
+    if feedback.IsRemoved {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "Feedback already removed",
+        })
+        return
+    }
+
+    // The comment stays in the tree so its replies remain reachable
+    feedback.IsRemoved = true
+    feedback.RemovalReason = msg.Reason
+    feedback.Body = removedBody
+    feedback.Revisions = nil
+    s.persistFeedback(feedback)
+    s.notifyRemoval(context, msg.UserHandle, content, feedback, msg.Reason)
+
+    log.Printf("User %s removed feedback %s from %s", msg.UserHandle, msg.FeedbackId, content.Subreddit)
+    context.Respond(&proto.ModerationResponse{
+        Success: true,
+        Message: "Feedback removed successfully",
+    })
+}
+
+func (s *SocialEngine) handleLockContent(context actor.Context, msg *proto.LockContent) {
+    content, forum, exists := s.lookupContent(msg.ContentId)
+    if !exists {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "Content not found",
+        })
+        return
+    }
+
+    forum.mutex.Lock()
+    defer forum.mutex.Unlock()
+
+    if !forum.canModerate(msg.UserHandle) {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "Only moderators can lock content",
+        })
+        return
+    }
+
+    content.IsLocked = msg.Locked
+    s.persistContent(content)
+
+    log.Printf("User %s set lock=%v on content %s", msg.UserHandle, msg.Locked, msg.ContentId)
+    context.Respond(&proto.ModerationResponse{
+        Success: true,
+        Message: "Lock updated successfully",
+    })
+}
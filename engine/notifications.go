@@ -0,0 +1,273 @@
+// engine/notifications.go
+package engine
+
+import (
+    "log"
+    "regexp"
+    "sort"
+    "github.com/asynkron/protoactor-go/actor"
+    protobuf "google.golang.org/protobuf/proto"
+    "reddit/proto"
+)
+
+// Kinds of notifications
+const (
+    NotifyPostReply    = "post_reply"
+    NotifyCommentReply = "comment_reply"
+    NotifyMention      = "mention"
+    NotifyRemoval      = "removal"
+    NotifyMessage      = "message"
+)
+
+const (
+    // maxNotifications is how many notifications are kept per user; older
+    // ones are dropped
+    maxNotifications = 500
+    // maxMentions is how many users one post or comment can notify by
+    // mentioning them
+    maxMentions = 10
+)
+
+// Mentions look like u/alice or /u/alice, but not like example.com/u/alice
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w/])/?u/([\w-]+)`)
+
+// Notifications are listed newest first
+var notificationListing = listing[*proto.Notification]{
+    key: func(notification *proto.Notification) cursor {
+        return cursor{float64(notification.Timestamp), notification.NotificationId}
+    },
+}
+
+// addNotification files a notification in its recipient's list, keeping the
+// list in listing order and within maxNotifications. Callers hold the
+// recipient's lock.
+func addNotification(user *UserData, notification *proto.Notification) {
+    notifications := user.notifications
+    key := notificationListing.key(notification)
+    position := sort.Search(len(notifications), func(i int) bool {
+        return key.precedes(notificationListing.key(notifications[i]), notificationListing.ascending)
+    })
+    notifications = append(notifications, nil)
+    copy(notifications[position+1:], notifications[position:])
+    notifications[position] = notification
+    if len(notifications) > maxNotifications {
+        notifications = notifications[:maxNotifications]
+    }
+    user.notifications = notifications
+}
+
+// notify stores a notification for its recipient and pushes it to them.
+// Nobody is notified of their own actions. The recipient is locked here, so
+// callers must not hold their lock.
+func (s *SocialEngine) notify(context actor.Context, notification *proto.Notification) {
+    if notification.Recipient == notification.Actor {
+        return
+    }
+    recipient, exists := s.users.get(notification.Recipient)
+    if !exists {
+        return
+    }
+
+    recipient.mutex.Lock()
+    notification.NotificationId = s.newID(context, "ntf")
+    notification.Timestamp = s.now(context).Unix()
+    addNotification(recipient, notification)
+    s.persistNotification(notification)
+    recipient.mutex.Unlock()
+
+    s.publishNotification(context, notification)
+}
+
+// mentions returns the users named in text, in the order they appear
+func (s *SocialEngine) mentions(text string) []string {
+    var handles []string
+    seen := make(map[string]bool)
+    for _, match := range mentionPattern.FindAllStringSubmatch(text, -1) {
+        handle := match[1]
+        if _, exists := s.users.get(handle); !exists || seen[handle] {
+            continue
+        }
+        seen[handle] = true
+        handles = append(handles, handle)
+        if len(handles) == maxMentions {
+            break
+        }
+    }
+    return handles
+}
+
+// notifyMentions tells the users mentioned in a post or comment about it,
+// except those in skip and those who cannot read it. Callers hold the lock
+// of the post's forum.
+func (s *SocialEngine) notifyMentions(context actor.Context, content *proto.Content, feedback *proto.Feedback, text string, skip string) {
+    for _, handle := range s.mentions(text) {
+        if handle == skip || !s.canViewContent(content, handle) {
+            continue
+        }
+        notification := &proto.Notification{
+            Recipient: handle,
+            Kind:      NotifyMention,
+            Subreddit: content.Subreddit,
+            ContentId: content.ContentId,
+        }
+        if feedback != nil {
+            notification.Actor = feedback.Creator
+            notification.FeedbackId = feedback.FeedbackId
+            notification.Excerpt = snippet(feedback.Body)
+        } else {
+            notification.Actor = content.Creator
+            notification.Excerpt = snippet(content.Heading)
+        }
+        s.notify(context, notification)
+    }
+}
+
+// notifyReply tells the author of a post or comment about a reply to it, and
+// anyone the reply mentions. Callers hold the lock of the post's forum.
+func (s *SocialEngine) notifyReply(context actor.Context, content *proto.Content, feedback *proto.Feedback) {
+    notification := &proto.Notification{
+        Kind:       NotifyPostReply,
+        Recipient:  content.Creator,
+        Actor:      feedback.Creator,
+        Subreddit:  content.Subreddit,
+        ContentId:  content.ContentId,
+        FeedbackId: feedback.FeedbackId,
+        Excerpt:    snippet(feedback.Body),
+    }
+    if parent, exists := s.feedbacks.get(feedback.ParentId); exists {
+        notification.Kind = NotifyCommentReply
+        notification.Recipient = parent.Creator
+    }
+    s.notify(context, notification)
+    s.notifyMentions(context, content, feedback, feedback.Body, notification.Recipient)
+}
+
+// notifyRemoval tells an author that a moderator removed their post or
+// comment. Callers hold the lock of the post's forum.
+func (s *SocialEngine) notifyRemoval(context actor.Context, moderator string, content *proto.Content, feedback *proto.Feedback, reason string) {
+    // Authors who deleted the item themselves are not told
+    if (feedback == nil && content.IsDeleted) || (feedback != nil && feedback.IsDeleted) {
+        return
+    }
+    notification := &proto.Notification{
+        Kind:      NotifyRemoval,
+        Recipient: content.Creator,
+        Actor:     moderator,
+        Subreddit: content.Subreddit,
+        ContentId: content.ContentId,
+        Excerpt:   reason,
+    }
+    if feedback != nil {
+        notification.Recipient = feedback.Creator
+        notification.FeedbackId = feedback.FeedbackId
+    }
+    s.notify(context, notification)
+}
+
+func (s *SocialEngine) notifyMessage(context actor.Context, chat *proto.DirectChat) {
+    s.notify(context, &proto.Notification{
+        Kind:      NotifyMessage,
+        Recipient: chat.Receiver,
+        Actor:     chat.Sender,
+        MessageId: chat.MessageId,
+        Excerpt:   snippet(chat.Content),
+    })
+}
+
+func (s *SocialEngine) publishNotification(context actor.Context, notification *proto.Notification) {
+    s.publish(context, &proto.Event{
+        Kind:         EventNotification,
+        Notification: protobuf.Clone(notification).(*proto.Notification),
+    }, func(sub *subscription) bool {
+        return sub.user == notification.Recipient
+    })
+}
+
+// clonedNotifications copies notifications so that they can be sent once
+// their recipient's lock is released
+func clonedNotifications(notifications []*proto.Notification) []*proto.Notification {
+    cloned := make([]*proto.Notification, len(notifications))
+    for i, notification := range notifications {
+        cloned[i] = protobuf.Clone(notification).(*proto.Notification)
+    }
+    return cloned
+}
+
+func (s *SocialEngine) handleGetNotifications(context actor.Context, msg *proto.GetNotifications) {
+    user, exists := s.users.get(msg.UserHandle)
+    if !exists {
+        context.Respond(&proto.NotificationList{
+            Success: false,
+            Message: "User not found",
+        })
+        return
+    }
+
+    user.mutex.RLock()
+    defer user.mutex.RUnlock()
+
+    var unread int32
+    notifications := make([]*proto.Notification, 0)
+    for _, notification := range user.notifications {
+        if !notification.Read {
+            unread++
+        } else if msg.UnreadOnly {
+            continue
+        }
+        notifications = append(notifications, notification)
+    }
+
+    result, err := notificationListing.paginate(notifications, msg.After, msg.Before, msg.Limit)
+    if err != nil {
+        context.Respond(&proto.NotificationList{
+            Success: false,
+            Message: "Invalid cursor",
+        })
+        return
+    }
+
+    context.Respond(&proto.NotificationList{
+        Success:       true,
+        Message:       "Notifications retrieved successfully",
+        Notifications: clonedNotifications(result.items),
+        Unread:        unread,
+        NextCursor:    result.next,
+        PrevCursor:    result.prev,
+    })
+}
+
+func (s *SocialEngine) handleMarkNotificationsRead(context actor.Context, msg *proto.MarkNotificationsRead) {
+    user, exists := s.users.get(msg.UserHandle)
+    if !exists {
+        context.Respond(&proto.MarkReadResponse{
+            Success: false,
+            Message: "User not found",
+        })
+        return
+    }
+
+    user.mutex.Lock()
+    defer user.mutex.Unlock()
+
+    wanted := make(map[string]bool, len(msg.NotificationIds))
+    for _, notificationId := range msg.NotificationIds {
+        wanted[notificationId] = true
+    }
+
+    var marked int32
+    for _, notification := range user.notifications {
+        if notification.Read || (len(wanted) > 0 && !wanted[notification.NotificationId]) {
+            continue
+        }
+        notification.Read = true
+        s.persistNotification(notification)
+        marked++
+    }
+
+    log.Printf("User %s read %d notifications", msg.UserHandle, marked)
+    context.Respond(&proto.MarkReadResponse{
+        Success: true,
+        Message: "Notifications marked as read",
+        Marked:  marked,
+    })
+}
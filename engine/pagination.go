@@ -0,0 +1,179 @@
+// engine/pagination.go
+package engine
+
+import (
+    "encoding/base64"
+    "errors"
+    "sort"
+    "strconv"
+    "strings"
+    "reddit/proto"
+)
+
+// Page sizes used when a request does not ask for one or asks for too many
+const (
+    defaultPageSize = 50
+    maxPageSize     = 100
+)
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// cursor marks a position in a listing by the rank and ID of an item. It
+// stays valid when items are added or removed around it.
+type cursor struct {
+    rank float64
+    id   string
+}
+
+// encode writes the cursor out along with the time the listing was ranked
+// at, if it depends on the time
+func (c cursor) encode(at int64) string {
+    raw := strconv.FormatFloat(c.rank, 'g', -1, 64)
+    if at != 0 {
+        raw += "@" + strconv.FormatInt(at, 10)
+    }
+    raw += ":" + c.id
+    return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeCursor reads a cursor and the time its listing was ranked at, which
+// is zero for listings that do not depend on the time
+func decodeCursor(encoded string) (cursor, int64, error) {
+    raw, err := base64.RawURLEncoding.DecodeString(encoded)
+    if err != nil {
+        return cursor{}, 0, errInvalidCursor
+    }
+    rank, id, found := strings.Cut(string(raw), ":")
+    if !found || id == "" {
+        return cursor{}, 0, errInvalidCursor
+    }
+    var at int64
+    rank, ranked, timed := strings.Cut(rank, "@")
+    if timed {
+        at, err = strconv.ParseInt(ranked, 10, 64)
+        if err != nil || at <= 0 {
+            return cursor{}, 0, errInvalidCursor
+        }
+    }
+    value, err := strconv.ParseFloat(rank, 64)
+    if err != nil {
+        return cursor{}, 0, errInvalidCursor
+    }
+    return cursor{rank: value, id: id}, at, nil
+}
+
+// precedes reports whether a comes before b in a listing. Listings run from
+// the highest rank down unless they are ascending; ties are broken by ID.
+func (a cursor) precedes(b cursor, ascending bool) bool {
+    if a.rank != b.rank {
+        return (a.rank < b.rank) == ascending
+    }
+    return a.id < b.id
+}
+
+// listing orders items by a cursor derived from each of them. A listing
+// ranked at a given time notes it in its cursors.
+type listing[T any] struct {
+    key       func(T) cursor
+    ascending bool
+    at        int64
+}
+
+type page[T any] struct {
+    items []T
+    next  string
+    prev  string
+}
+
+// sorted returns a sorted copy of items, leaving the original untouched
+func (l listing[T]) sorted(items []T) []T {
+    sorted := append([]T(nil), items...)
+    sort.SliceStable(sorted, func(i, j int) bool {
+        return l.key(sorted[i]).precedes(l.key(sorted[j]), l.ascending)
+    })
+    return sorted
+}
+
+// paginate returns up to limit sorted items following the after cursor or,
+// failing that, leading up to the before cursor. The page carries cursors
+// for its neighbours when there are more items in that direction.
+func (l listing[T]) paginate(items []T, after, before string, limit int32) (page[T], error) {
+    size := int(limit)
+    if size <= 0 {
+        size = defaultPageSize
+    }
+    if size > maxPageSize {
+        size = maxPageSize
+    }
+
+    start, end := 0, len(items)
+    if after != "" {
+        position, _, err := decodeCursor(after)
+        if err != nil {
+            return page[T]{}, err
+        }
+        start = sort.Search(len(items), func(i int) bool {
+            return position.precedes(l.key(items[i]), l.ascending)
+        })
+        end = min(start+size, len(items))
+    } else if before != "" {
+        position, _, err := decodeCursor(before)
+        if err != nil {
+            return page[T]{}, err
+        }
+        end = sort.Search(len(items), func(i int) bool {
+            return !l.key(items[i]).precedes(position, l.ascending)
+        })
+        start = max(end-size, 0)
+    } else {
+        end = min(size, len(items))
+    }
+
+    result := page[T]{items: items[start:end]}
+    if start > 0 && start < len(items) {
+        result.prev = l.key(items[start]).encode(l.at)
+    }
+    if end < len(items) && end > 0 {
+        result.next = l.key(items[end-1]).encode(l.at)
+    }
+    return result, nil
+}
+
+// unnumberedChats ranks messages stored before messages were numbered below
+// every numbered one, by the time they were sent
+const unnumberedChats = 1e12
+
+// Replies and direct messages are listed oldest first. Messages sent within
+// the same second keep the order they were delivered in.
+var (
+    replyListing = listing[*proto.Feedback]{
+        key: func(feedback *proto.Feedback) cursor {
+            return cursor{float64(feedback.Timestamp), feedback.FeedbackId}
+        },
+        ascending: true,
+    }
+    chatListing = listing[*proto.DirectChat]{
+        key: func(chat *proto.DirectChat) cursor {
+            if chat.Sequence == 0 {
+                return cursor{float64(chat.Timestamp) - unnumberedChats, chat.MessageId}
+            }
+            return cursor{float64(chat.Sequence), chat.MessageId}
+        },
+        ascending: true,
+    }
+)
+
+// reactionCounts counts the upvotes and downvotes that are not quarantined
+func (s *SocialEngine) reactionCounts(reactions map[string]int32) (ups int, downs int) {
+    for handle, value := range reactions {
+        if s.isQuarantined(handle) {
+            continue
+        }
+        if value > 0 {
+            ups++
+        } else {
+            downs++
+        }
+    }
+    return ups, downs
+}
@@ -0,0 +1,209 @@
+// engine/pagination_test.go
+package engine
+
+import (
+    "encoding/base64"
+    "fmt"
+    "testing"
+    "reddit/proto"
+)
+
+type rankedItem struct {
+    id   string
+    rank float64
+}
+
+var testListing = listing[rankedItem]{
+    key: func(item rankedItem) cursor {
+        return cursor{item.rank, item.id}
+    },
+}
+
+// rankedItems returns n items ranked n down to 1, sorted for the listing
+func rankedItems(n int) []rankedItem {
+    items := make([]rankedItem, n)
+    for i := range items {
+        items[i] = rankedItem{id: fmt.Sprintf("item%03d", i), rank: float64(n - i)}
+    }
+    return testListing.sorted(items)
+}
+
+func ids(items []rankedItem) []string {
+    result := make([]string, len(items))
+    for i, item := range items {
+        result[i] = item.id
+    }
+    return result
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+    tests := []struct {
+        cursor cursor
+        at     int64
+    }{
+        {cursor{42, "cnt_abc"}, 0},
+        {cursor{-3.5, "fbk_xyz"}, 0},
+        {cursor{1.25e-7, "cnt_hot"}, 1700000000},
+        {cursor{0, "id:with:colons"}, 0},
+    }
+    for _, test := range tests {
+        decoded, at, err := decodeCursor(test.cursor.encode(test.at))
+        if err != nil {
+            t.Errorf("decoding %v: %v", test.cursor, err)
+            continue
+        }
+        if decoded != test.cursor || at != test.at {
+            t.Errorf("round trip of %v at %d = %v at %d", test.cursor, test.at, decoded, at)
+        }
+    }
+}
+
+func TestDecodeCursorRejectsGarbage(t *testing.T) {
+    encode := func(raw string) string {
+        return base64.RawURLEncoding.EncodeToString([]byte(raw))
+    }
+    for _, encoded := range []string{
+        "not base64!",
+        encode("12"),
+        encode("12:"),
+        encode("rank:cnt_abc"),
+        encode("12@soon:cnt_abc"),
+        encode("12@-5:cnt_abc"),
+    } {
+        if _, _, err := decodeCursor(encoded); err != errInvalidCursor {
+            t.Errorf("decodeCursor(%q) = %v, want errInvalidCursor", encoded, err)
+        }
+    }
+}
+
+func TestSortedBreaksTiesByID(t *testing.T) {
+    items := []rankedItem{{"b", 1}, {"c", 2}, {"a", 1}}
+    got := ids(testListing.sorted(items))
+    want := []string{"c", "a", "b"}
+    if fmt.Sprint(got) != fmt.Sprint(want) {
+        t.Errorf("sorted = %v, want %v", got, want)
+    }
+    if items[0].id != "b" {
+        t.Error("sorted changed the original slice")
+    }
+
+    ascending := listing[rankedItem]{key: testListing.key, ascending: true}
+    got = ids(ascending.sorted(items))
+    want = []string{"a", "b", "c"}
+    if fmt.Sprint(got) != fmt.Sprint(want) {
+        t.Errorf("ascending sorted = %v, want %v", got, want)
+    }
+}
+
+func TestPaginateWalksBothWays(t *testing.T) {
+    items := rankedItems(7)
+
+    first, err := testListing.paginate(items, "", "", 3)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if fmt.Sprint(ids(first.items)) != fmt.Sprint(ids(items[0:3])) || first.prev != "" || first.next == "" {
+        t.Fatalf("first page = %v prev %q next %q", ids(first.items), first.prev, first.next)
+    }
+
+    second, err := testListing.paginate(items, first.next, "", 3)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if fmt.Sprint(ids(second.items)) != fmt.Sprint(ids(items[3:6])) || second.prev == "" || second.next == "" {
+        t.Fatalf("second page = %v prev %q next %q", ids(second.items), second.prev, second.next)
+    }
+
+    last, err := testListing.paginate(items, second.next, "", 3)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if fmt.Sprint(ids(last.items)) != fmt.Sprint(ids(items[6:])) || last.next != "" {
+        t.Fatalf("last page = %v next %q", ids(last.items), last.next)
+    }
+
+    back, err := testListing.paginate(items, "", second.prev, 3)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if fmt.Sprint(ids(back.items)) != fmt.Sprint(ids(first.items)) || back.prev != "" {
+        t.Errorf("page before the second = %v prev %q, want the first page", ids(back.items), back.prev)
+    }
+}
+
+func TestPaginateSurvivesInsertions(t *testing.T) {
+    items := rankedItems(6)
+    first, err := testListing.paginate(items, "", "", 3)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // A new item ranked above everything must not shift the next page
+    items = testListing.sorted(append(items, rankedItem{"fresh", 100}))
+    second, err := testListing.paginate(items, first.next, "", 3)
+    if err != nil {
+        t.Fatal(err)
+    }
+    want := []string{"item003", "item004", "item005"}
+    if fmt.Sprint(ids(second.items)) != fmt.Sprint(want) {
+        t.Errorf("second page = %v, want %v", ids(second.items), want)
+    }
+}
+
+func TestPaginateClampsLimit(t *testing.T) {
+    items := rankedItems(maxPageSize + 20)
+
+    page, err := testListing.paginate(items, "", "", 0)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(page.items) != defaultPageSize {
+        t.Errorf("default page has %d items, want %d", len(page.items), defaultPageSize)
+    }
+
+    page, err = testListing.paginate(items, "", "", maxPageSize*2)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(page.items) != maxPageSize {
+        t.Errorf("oversized page has %d items, want %d", len(page.items), maxPageSize)
+    }
+}
+
+func TestPaginateRejectsInvalidCursor(t *testing.T) {
+    items := rankedItems(3)
+    if _, err := testListing.paginate(items, "bogus!", "", 2); err != errInvalidCursor {
+        t.Errorf("after = %v, want errInvalidCursor", err)
+    }
+    if _, err := testListing.paginate(items, "", "bogus!", 2); err != errInvalidCursor {
+        t.Errorf("before = %v, want errInvalidCursor", err)
+    }
+}
+
+func TestPaginateCarriesRankingTime(t *testing.T) {
+    timed := listing[rankedItem]{key: testListing.key, at: 1700000000}
+    page, err := timed.paginate(rankedItems(4), "", "", 2)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if _, at, err := decodeCursor(page.next); err != nil || at != 1700000000 {
+        t.Errorf("next cursor ranked at %d (%v), want 1700000000", at, err)
+    }
+}
+
+func TestChatListingPutsUnnumberedMessagesFirst(t *testing.T) {
+    chats := []*proto.DirectChat{
+        {MessageId: "msg_new", Sequence: 2, Timestamp: 1700000100},
+        {MessageId: "msg_old", Timestamp: 1600000000},
+        {MessageId: "msg_first", Sequence: 1, Timestamp: 1700000100},
+        {MessageId: "msg_older", Timestamp: 1500000000},
+    }
+    var got []string
+    for _, chat := range chatListing.sorted(chats) {
+        got = append(got, chat.MessageId)
+    }
+    want := []string{"msg_older", "msg_old", "msg_first", "msg_new"}
+    if fmt.Sprint(got) != fmt.Sprint(want) {
+        t.Errorf("chats listed as %v, want %v", got, want)
+    }
+}
@@ -0,0 +1,442 @@
+// engine/persistence.go
+package engine
+
+import (
+    "fmt"
+    "log"
+    "sort"
+    "time"
+    protobuf "google.golang.org/protobuf/proto"
+    "reddit/proto"
+)
+
+// snapshotEvery is the number of records appended to the store before the
+// engine compacts its state into a fresh snapshot.
+const snapshotEvery = 1000
+
+// Restore rebuilds the engine state from its store. It must be called before
+// the engine actor is spawned.
+func (s *SocialEngine) Restore() error {
+    snapshot, records, err := s.store.Load()
+    if err != nil {
+        return fmt.Errorf("failed to load state: %v", err)
+    }
+
+    if snapshot != nil {
+        s.applySnapshot(snapshot)
+    }
+    for _, record := range records {
+        s.applyRecord(record)
+    }
+    s.pending.Store(int64(len(records)))
+    s.rebuild()
+
+    log.Printf("Restored %d users, %d forums, %d posts and %d comments (%d log records)",
+        s.users.len(), s.forums.len(), s.contents.len(), s.feedbacks.len(), len(records))
+    return nil
+}
+
+// rebuild derives the indexes kept alongside the state once the state has
+// been loaded
+func (s *SocialEngine) rebuild() {
+    s.rebuildQuarantine()
+    s.rebuildSearchIndex()
+    s.rebuildConversations()
+    s.rebuildCrossposts()
+    s.rebuildMedia()
+    s.rebuildScores()
+}
+
+// reset empties the state so that a replica can load the engine's. Queries
+// running meanwhile may see some of the old state or none of it.
+func (s *SocialEngine) reset() {
+    s.users.clear()
+    s.forums.clear()
+    s.contents.clear()
+    s.feedbacks.clear()
+    s.scores.clear()
+    s.quarantined.clear()
+    s.search.clear()
+    s.crossposts.clear()
+    s.media.clear()
+    s.ledger.reset()
+}
+
+// rebuildQuarantine lists the users whose votes are quarantined
+func (s *SocialEngine) rebuildQuarantine() {
+    s.quarantined.clear()
+    for handle, user := range s.users.all() {
+        user.mutex.RLock()
+        if user.VoteFlag != "" {
+            s.quarantined.put(handle, user.VoteFlag)
+        }
+        user.mutex.RUnlock()
+    }
+}
+
+func (s *SocialEngine) applySnapshot(snapshot *proto.EngineSnapshot) {
+    for _, record := range snapshot.Users {
+        s.applyUser(record)
+    }
+    for _, content := range snapshot.Contents {
+        s.contents.put(content.ContentId, content)
+        for _, feedback := range content.Feedback {
+            s.indexFeedback(feedback)
+        }
+    }
+    for _, record := range snapshot.Forums {
+        s.applyForum(record)
+    }
+    for _, record := range snapshot.Chats {
+        if receiver, exists := s.users.get(record.Receiver); exists {
+            receiver.mutex.Lock()
+            receiver.inbox = append(receiver.inbox, record.Messages...)
+            receiver.mutex.Unlock()
+        }
+    }
+    for _, notification := range snapshot.Notifications {
+        s.applyNotification(notification)
+    }
+    for _, vote := range snapshot.Ledger {
+        s.ledger.apply(vote)
+    }
+}
+
+func (s *SocialEngine) indexFeedback(feedback *proto.Feedback) {
+    s.feedbacks.put(feedback.FeedbackId, feedback)
+    for _, reply := range feedback.Replies {
+        s.indexFeedback(reply)
+    }
+}
+
+func (s *SocialEngine) applyRecord(record *proto.StoreRecord) {
+    switch entry := record.Entry.(type) {
+    case *proto.StoreRecord_User:
+        s.applyUser(entry.User)
+    case *proto.StoreRecord_Forum:
+        s.applyForum(entry.Forum)
+    case *proto.StoreRecord_Content:
+        s.applyContent(entry.Content)
+    case *proto.StoreRecord_Feedback:
+        s.applyFeedback(entry.Feedback)
+    case *proto.StoreRecord_Chat:
+        s.applyChat(entry.Chat)
+    case *proto.StoreRecord_Notification:
+        s.applyNotification(entry.Notification)
+    case *proto.StoreRecord_LedgerVote:
+        s.ledger.apply(entry.LedgerVote)
+    }
+}
+
+// applyUser upserts a user. An existing user keeps their inbox and
+// notifications, which are stored as records of their own.
+func (s *SocialEngine) applyUser(record *proto.UserRecord) {
+    user, exists := s.users.get(record.Handle)
+    if !exists {
+        user = &UserData{Handle: record.Handle}
+    }
+
+    user.mutex.Lock()
+    user.PasswordHash = record.PasswordHash
+    user.Points = int(record.Points)
+    user.PostKarma = int(record.PostKarma)
+    user.CommentKarma = int(record.CommentKarma)
+    user.Forums = make(map[string]bool)
+    user.IsOnline = record.IsOnline
+    user.LastSeen = time.Unix(record.LastSeen, 0)
+    user.Created = time.Unix(record.Created, 0)
+    user.VoteFlag = record.VoteFlag
+    user.FlaggedAt = time.Time{}
+    if record.FlaggedAt != 0 {
+        user.FlaggedAt = time.Unix(record.FlaggedAt, 0)
+    }
+    for _, forumName := range record.Forums {
+        user.Forums[forumName] = true
+    }
+    user.mutex.Unlock()
+
+    if !exists {
+        s.users.put(record.Handle, user)
+    }
+}
+
+func (s *SocialEngine) applyForum(record *proto.ForumRecord) {
+    forum := &ForumData{
+        Name:       record.Name,
+        Owner:      record.Owner,
+        Creator:    record.Creator,
+        Moderators: make(map[string]bool),
+        Members:    make(map[string]bool),
+        Banned:     make(map[string]string),
+        Contents:   make([]*proto.Content, 0, len(record.ContentIds)),
+        Created:    time.Unix(record.Created, 0),
+
+        Invited:      make(map[string]bool),
+        Approved:     make(map[string]bool),
+        JoinRequests: make(map[string]*proto.JoinRequest),
+    }
+    forum.applySettings(record.Settings)
+    // Forums stored before creators were recorded are still owned by them
+    if forum.Creator == "" {
+        forum.Creator = forum.Owner
+    }
+    for _, moderator := range record.Moderators {
+        forum.Moderators[moderator] = true
+    }
+    for _, member := range record.Members {
+        forum.Members[member] = true
+    }
+    for handle, reason := range record.Banned {
+        forum.Banned[handle] = reason
+    }
+    for _, handle := range record.Invited {
+        forum.Invited[handle] = true
+    }
+    for _, handle := range record.Approved {
+        forum.Approved[handle] = true
+    }
+    for _, request := range record.JoinRequests {
+        forum.JoinRequests[request.UserHandle] = request
+    }
+    for _, contentId := range record.ContentIds {
+        if content, exists := s.contents.get(contentId); exists {
+            forum.Contents = append(forum.Contents, content)
+        }
+    }
+    s.forums.put(record.Name, forum)
+}
+
+// applyContent upserts a post. Records never carry comments, so an existing
+// post keeps the comment tree it already has. Stored records are only
+// applied during a restore, before anything else reads the state.
+func (s *SocialEngine) applyContent(record *proto.Content) {
+    if content, exists := s.contents.get(record.ContentId); exists {
+        feedback := content.Feedback
+        protobuf.Reset(content)
+        protobuf.Merge(content, record)
+        content.Feedback = feedback
+        return
+    }
+
+    record.Feedback = make([]*proto.Feedback, 0)
+    s.contents.put(record.ContentId, record)
+}
+
+// applyFeedback upserts a comment, attaching new ones to their parent.
+// Records never carry replies, so an existing comment keeps its subtree.
+func (s *SocialEngine) applyFeedback(record *proto.Feedback) {
+    if feedback, exists := s.feedbacks.get(record.FeedbackId); exists {
+        replies := feedback.Replies
+        protobuf.Reset(feedback)
+        protobuf.Merge(feedback, record)
+        feedback.Replies = replies
+        return
+    }
+
+    record.Replies = make([]*proto.Feedback, 0)
+    if record.ParentId == "" {
+        content, exists := s.contents.get(record.ContentId)
+        if !exists {
+            log.Printf("Dropping comment %s for unknown post %s", record.FeedbackId, record.ContentId)
+            return
+        }
+        content.Feedback = append(content.Feedback, record)
+    } else {
+        parent, exists := s.feedbacks.get(record.ParentId)
+        if !exists {
+            log.Printf("Dropping comment %s for unknown parent %s", record.FeedbackId, record.ParentId)
+            return
+        }
+        parent.Replies = append(parent.Replies, record)
+    }
+    s.feedbacks.put(record.FeedbackId, record)
+}
+
+// applyChat upserts a message in its receiver's inbox
+func (s *SocialEngine) applyChat(record *proto.DirectChat) {
+    receiver, exists := s.users.get(record.Receiver)
+    if !exists {
+        log.Printf("Dropping message %s for unknown user %s", record.MessageId, record.Receiver)
+        return
+    }
+
+    receiver.mutex.Lock()
+    defer receiver.mutex.Unlock()
+
+    for i, message := range receiver.inbox {
+        if message.MessageId == record.MessageId {
+            receiver.inbox[i] = record
+            return
+        }
+    }
+    receiver.inbox = append(receiver.inbox, record)
+}
+
+// applyNotification upserts a notification. One dropped for being too old
+// is dropped again.
+func (s *SocialEngine) applyNotification(record *proto.Notification) {
+    recipient, exists := s.users.get(record.Recipient)
+    if !exists {
+        return
+    }
+
+    recipient.mutex.Lock()
+    defer recipient.mutex.Unlock()
+
+    for i, notification := range recipient.notifications {
+        if notification.NotificationId == record.NotificationId {
+            recipient.notifications[i] = record
+            return
+        }
+    }
+    addNotification(recipient, record)
+}
+
+func (s *SocialEngine) persistUser(user *UserData) {
+    s.reindexUser(user)
+    s.persist(&proto.StoreRecord{Entry: &proto.StoreRecord_User{User: userRecord(user)}})
+}
+
+func (s *SocialEngine) persistForum(forum *ForumData) {
+    s.reindexForum(forum)
+    s.persist(&proto.StoreRecord{Entry: &proto.StoreRecord_Forum{Forum: forumRecord(forum)}})
+}
+
+func (s *SocialEngine) persistContent(content *proto.Content) {
+    s.reindexContent(content)
+    s.rescore(content)
+    record := protobuf.Clone(content).(*proto.Content)
+    record.Feedback = nil
+    s.persist(&proto.StoreRecord{Entry: &proto.StoreRecord_Content{Content: record}})
+}
+
+func (s *SocialEngine) persistFeedback(feedback *proto.Feedback) {
+    s.reindexFeedback(feedback)
+    record := protobuf.Clone(feedback).(*proto.Feedback)
+    record.Replies = nil
+    s.persist(&proto.StoreRecord{Entry: &proto.StoreRecord_Feedback{Feedback: record}})
+}
+
+func (s *SocialEngine) persistChat(chat *proto.DirectChat) {
+    s.persist(&proto.StoreRecord{Entry: &proto.StoreRecord_Chat{Chat: chat}})
+}
+
+func (s *SocialEngine) persistNotification(notification *proto.Notification) {
+    s.persist(&proto.StoreRecord{Entry: &proto.StoreRecord_Notification{Notification: notification}})
+}
+
+func (s *SocialEngine) persistLedgerVote(vote *proto.LedgerVote) {
+    s.persist(&proto.StoreRecord{Entry: &proto.StoreRecord_LedgerVote{LedgerVote: vote}})
+}
+
+// persist appends a record to the store. Once enough records have piled up
+// the state is due to be compacted into a new snapshot, which the engine
+// writes as soon as no shard is applying a command; see compactIfDue.
+func (s *SocialEngine) persist(record *proto.StoreRecord) {
+    if err := s.store.Append(record); err != nil {
+        log.Printf("Failed to persist record: %v", err)
+        return
+    }
+
+    if s.pending.Add(1) >= snapshotEvery {
+        s.compactDue.Store(true)
+    }
+}
+
+// Snapshot compacts the current state into a new snapshot. It must not be
+// called while commands are being applied, such as after the engine actor
+// has stopped.
+func (s *SocialEngine) Snapshot() {
+    s.snapshot()
+}
+
+func (s *SocialEngine) snapshot() {
+    snapshot := s.snapshotState()
+    if err := s.store.Snapshot(snapshot); err != nil {
+        log.Printf("Failed to write snapshot: %v", err)
+        return
+    }
+    s.pending.Store(0)
+    log.Printf("Snapshot written with %d users, %d forums and %d posts",
+        len(snapshot.Users), len(snapshot.Forums), len(snapshot.Contents))
+}
+
+// snapshotState collects the state as of the last journal entry applied.
+// The snapshot shares the live posts and messages, so callers make sure no
+// command is applied for as long as they use it.
+func (s *SocialEngine) snapshotState() *proto.EngineSnapshot {
+    snapshot := &proto.EngineSnapshot{
+        TakenAt:  time.Now().Unix(),
+        Users:    make([]*proto.UserRecord, 0, s.users.len()),
+        Forums:   make([]*proto.ForumRecord, 0, s.forums.len()),
+        Contents: make([]*proto.Content, 0, s.contents.len()),
+        Sequence: s.sequence,
+        Ledger:   s.ledger.votes(),
+    }
+    for _, user := range s.users.all() {
+        user.mutex.RLock()
+        snapshot.Users = append(snapshot.Users, userRecord(user))
+        if len(user.inbox) > 0 {
+            snapshot.Chats = append(snapshot.Chats, &proto.ChatRecord{
+                Receiver: user.Handle,
+                Messages: append([]*proto.DirectChat(nil), user.inbox...),
+            })
+        }
+        snapshot.Notifications = append(snapshot.Notifications, user.notifications...)
+        user.mutex.RUnlock()
+    }
+    for _, forum := range s.forums.all() {
+        forum.mutex.RLock()
+        snapshot.Forums = append(snapshot.Forums, forumRecord(forum))
+        forum.mutex.RUnlock()
+    }
+    for _, content := range s.contents.all() {
+        snapshot.Contents = append(snapshot.Contents, content)
+    }
+    return snapshot
+}
+
+func userRecord(user *UserData) *proto.UserRecord {
+    record := &proto.UserRecord{
+        Handle:       user.Handle,
+        PasswordHash: user.PasswordHash,
+        Points:       int32(user.Points),
+        PostKarma:    int32(user.PostKarma),
+        CommentKarma: int32(user.CommentKarma),
+        Forums:   user.forumList(),
+        IsOnline: user.IsOnline,
+        LastSeen: user.LastSeen.Unix(),
+        Created:  user.Created.Unix(),
+        VoteFlag: user.VoteFlag,
+    }
+    if !user.FlaggedAt.IsZero() {
+        record.FlaggedAt = user.FlaggedAt.Unix()
+    }
+    return record
+}
+
+func forumRecord(forum *ForumData) *proto.ForumRecord {
+    record := &proto.ForumRecord{
+        Name:       forum.Name,
+        Owner:      forum.Owner,
+        Moderators: forum.moderatorList(),
+        Members:    make([]string, 0, len(forum.Members)),
+        ContentIds: make([]string, 0, len(forum.Contents)),
+        Created:    forum.Created.Unix(),
+        Banned:     forum.Banned,
+        Creator:    forum.Creator,
+        Settings:   forum.settings(),
+
+        Invited:      sortedHandles(forum.Invited),
+        Approved:     sortedHandles(forum.Approved),
+        JoinRequests: forum.joinRequestList(),
+    }
+    for member := range forum.Members {
+        record.Members = append(record.Members, member)
+    }
+    sort.Strings(record.Members)
+    for _, content := range forum.Contents {
+        record.ContentIds = append(record.ContentIds, content.ContentId)
+    }
+    return record
+}
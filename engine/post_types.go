@@ -0,0 +1,107 @@
+// engine/post_types.go
+package engine
+
+import (
+    "net/url"
+    "strings"
+    "github.com/asynkron/protoactor-go/actor"
+    "reddit/proto"
+)
+
+// Kinds of posts
+const (
+    PostText  = "text"
+    PostLink  = "link"
+    PostMedia = "media"
+)
+
+const maxURLLength = 2048
+
+// linkDomain validates a link post's URL and returns the domain it is shown
+// with, or an empty string if the URL cannot be linked to
+func linkDomain(raw string) string {
+    if len(raw) > maxURLLength {
+        return ""
+    }
+    parsed, err := url.Parse(raw)
+    if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+        return ""
+    }
+    host := strings.ToLower(parsed.Hostname())
+    if host == "" || parsed.User != nil {
+        return ""
+    }
+    return strings.TrimPrefix(host, "www.")
+}
+
+// typePost checks the type-specific fields of a new post and copies them
+// onto it. It returns a message explaining why the post was rejected, or an
+// empty string.
+func typePost(content *proto.Content, msg *proto.CreateContent) string {
+    switch msg.Kind {
+    case "", PostText:
+        content.Kind = PostText
+    case PostLink:
+        domain := linkDomain(msg.Url)
+        if domain == "" {
+            return "Link must be a valid http or https URL"
+        }
+        content.Kind = PostLink
+        content.Url = msg.Url
+        content.Domain = domain
+    case PostMedia:
+        if msg.Media == nil || msg.Media.Hash == "" {
+            return "Media post needs an uploaded file"
+        }
+        content.Kind = PostMedia
+        content.Media = msg.Media
+    default:
+        return "Unknown post type"
+    }
+    return ""
+}
+
+// rebuildMedia indexes the posts carrying each uploaded file after a
+// restore
+func (s *SocialEngine) rebuildMedia() {
+    s.media.clear()
+    for _, content := range s.contents.all() {
+        if content.Media != nil {
+            s.media.add(content.Media.Hash, content)
+        }
+    }
+}
+
+// handleGetMedia lets a viewer see an uploaded file when they can read a
+// visible post that carries it. The same file may be uploaded to several
+// posts, so one readable post is enough.
+func (s *SocialEngine) handleGetMedia(context actor.Context, msg *proto.GetMedia) {
+    for _, content := range s.media.list(msg.Hash) {
+        if s.canReadPost(content, msg.Viewer) {
+            context.Respond(&proto.MediaAccess{
+                Success: true,
+                Message: "Media found",
+            })
+            return
+        }
+    }
+
+    context.Respond(&proto.MediaAccess{
+        Success: false,
+        Message: "Media not found",
+    })
+}
+
+// canReadPost reports whether a post is visible and the viewer may read it,
+// locking the post's forum to find out
+func (s *SocialEngine) canReadPost(content *proto.Content, viewer string) bool {
+    forum, exists := s.forums.get(content.Subreddit)
+    if !exists {
+        return false
+    }
+
+    forum.mutex.RLock()
+    defer forum.mutex.RUnlock()
+
+    return isVisible(content) && forum.canView(viewer)
+}
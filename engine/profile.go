@@ -0,0 +1,114 @@
+// engine/profile.go
+package engine
+
+import (
+    "sort"
+    "time"
+    "github.com/asynkron/protoactor-go/actor"
+    "reddit/proto"
+)
+
+// onlineTimeout is how long a user counts as online after they were last seen
+const onlineTimeout = 5 * time.Minute
+
+// Karma returns the user's combined post and comment karma. Callers hold
+// the user's lock; see currentKarma.
+func (u *UserData) Karma() int {
+    return u.PostKarma + u.CommentKarma
+}
+
+// currentKarma reads the user's karma under their lock
+func (u *UserData) currentKarma() int {
+    u.mutex.RLock()
+    defer u.mutex.RUnlock()
+
+    return u.Karma()
+}
+
+// isOnline reports whether the user is online and has been seen recently
+func (u *UserData) isOnline(now time.Time) bool {
+    return u.IsOnline && now.Sub(u.LastSeen) < onlineTimeout
+}
+
+func (u *UserData) forumList() []string {
+    forums := make([]string, 0, len(u.Forums))
+    for forumName := range u.Forums {
+        forums = append(forums, forumName)
+    }
+    sort.Strings(forums)
+    return forums
+}
+
+// visibleForums returns the forums the user belongs to that the viewer can
+// see. Users always see all of their own forums. The user's list is copied
+// first, so that no forum is locked while the user is.
+func (s *SocialEngine) visibleForums(user *UserData, viewer string) []string {
+    user.mutex.RLock()
+    joined := user.forumList()
+    user.mutex.RUnlock()
+
+    if viewer == user.Handle {
+        return joined
+    }
+    forums := make([]string, 0, len(joined))
+    for _, forumName := range joined {
+        if forum, exists := s.forums.get(forumName); exists {
+            forum.mutex.RLock()
+            visible := forum.canView(viewer)
+            forum.mutex.RUnlock()
+            if !visible {
+                continue
+            }
+        }
+        forums = append(forums, forumName)
+    }
+    return forums
+}
+
+// adjustKarma credits a vote change to the author of a post or comment. The
+// author is locked here, so callers must not hold their lock.
+func (s *SocialEngine) adjustKarma(handle string, isContent bool, delta int32) {
+    user, exists := s.users.get(handle)
+    if !exists || delta == 0 {
+        return
+    }
+
+    user.mutex.Lock()
+    defer user.mutex.Unlock()
+
+    if isContent {
+        user.PostKarma += int(delta)
+    } else {
+        user.CommentKarma += int(delta)
+    }
+    user.Points = user.Karma()
+    s.persistUser(user)
+}
+
+func (s *SocialEngine) handleGetUserProfile(context actor.Context, msg *proto.GetUserProfile) {
+    user, exists := s.users.get(msg.UserHandle)
+    if !exists {
+        context.Respond(&proto.UserProfile{
+            Success: false,
+            Message: "User not found",
+        })
+        return
+    }
+
+    user.mutex.RLock()
+    profile := &proto.UserProfile{
+        Success:      true,
+        Message:      "Profile retrieved successfully",
+        Username:     user.Handle,
+        Karma:        int32(user.Karma()),
+        PostKarma:    int32(user.PostKarma),
+        CommentKarma: int32(user.CommentKarma),
+        CakeDay:      user.Created.Unix(),
+        IsOnline:     user.isOnline(time.Now()),
+        LastSeen:     user.LastSeen.Unix(),
+    }
+    user.mutex.RUnlock()
+
+    profile.Forums = s.visibleForums(user, msg.Viewer)
+    context.Respond(profile)
+}
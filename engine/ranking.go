@@ -0,0 +1,234 @@
+// engine/ranking.go
+package engine
+
+import (
+    "errors"
+    "math"
+    "time"
+    "reddit/proto"
+    "reddit/utils"
+)
+
+var (
+    errUnknownSort   = errors.New("unknown sort method")
+    errUnknownPeriod = errors.New("unknown time filter")
+)
+
+// Time windows a listing or search can be limited to, as in Reddit's ?t=
+// parameter
+var listingPeriods = map[string]time.Duration{
+    "hour":  time.Hour,
+    "day":   24 * time.Hour,
+    "week":  7 * 24 * time.Hour,
+    "month": 30 * 24 * time.Hour,
+    "year":  365 * 24 * time.Hour,
+}
+
+// PostScore holds the vote-derived scores of a post. They are worked out
+// once whenever the post changes, so listings never recount votes.
+type PostScore struct {
+    Ups           int
+    Downs         int
+    Hot           float64
+    Best          float64
+    Controversial float64
+}
+
+func (s *SocialEngine) scorePost(content *proto.Content) PostScore {
+    ups, downs := s.reactionCounts(content.Reactions)
+    return PostScore{
+        Ups:           ups,
+        Downs:         downs,
+        Hot:           utils.CalculateHotScore(ups, downs, content.Timestamp),
+        Best:          utils.CalculateBestScore(ups, downs),
+        Controversial: utils.CalculateControversyScore(ups, downs),
+    }
+}
+
+// Ranker scores posts for one sort order. Listings show the highest scores
+// first.
+type Ranker interface {
+    Rank(content *proto.Content, score PostScore, now time.Time) float64
+}
+
+// RankerFunc lets an ordinary function be used as a Ranker
+type RankerFunc func(content *proto.Content, score PostScore, now time.Time) float64
+
+func (f RankerFunc) Rank(content *proto.Content, score PostScore, now time.Time) float64 {
+    return f(content, score, now)
+}
+
+// A Ranker that also implements MaxAge only lists posts younger than that
+type agedRanker interface {
+    MaxAge() time.Duration
+}
+
+var rankers = make(map[string]Ranker)
+
+// RegisterRanker makes a ranker available as a sort method. It is meant to
+// be called during initialisation.
+func RegisterRanker(name string, ranker Ranker) {
+    rankers[name] = ranker
+}
+
+// risingRanker favours young posts that are gaining votes quickly
+type risingRanker struct{}
+
+const risingWindow = 24 * time.Hour
+
+func (risingRanker) Rank(content *proto.Content, score PostScore, now time.Time) float64 {
+    hours := math.Max(now.Sub(time.Unix(content.Timestamp, 0)).Hours(), 1.0/60)
+    return float64(score.Ups-score.Downs) / math.Pow(hours, 1.5)
+}
+
+func (risingRanker) MaxAge() time.Duration {
+    return risingWindow
+}
+
+func init() {
+    RegisterRanker("new", RankerFunc(func(content *proto.Content, _ PostScore, _ time.Time) float64 {
+        return float64(content.Timestamp)
+    }))
+    RegisterRanker("top", RankerFunc(func(content *proto.Content, _ PostScore, _ time.Time) float64 {
+        return float64(content.Points)
+    }))
+    RegisterRanker("hot", RankerFunc(func(_ *proto.Content, score PostScore, _ time.Time) float64 {
+        return score.Hot
+    }))
+    RegisterRanker("best", RankerFunc(func(_ *proto.Content, score PostScore, _ time.Time) float64 {
+        return score.Best
+    }))
+    RegisterRanker("controversial", RankerFunc(func(_ *proto.Content, score PostScore, _ time.Time) float64 {
+        return score.Controversial
+    }))
+    RegisterRanker("rising", risingRanker{})
+}
+
+// postRanking is the order and time window chosen for one listing
+type postRanking struct {
+    listing[*proto.Content]
+    since int64
+}
+
+// ranking looks up the ranker for a sort method, newest first by default,
+// and the time window to list. The listing reads the posts it ranks, so
+// callers hold their forums' locks while it sorts or pages them.
+func (s *SocialEngine) ranking(sortMethod, period string, now time.Time) (postRanking, error) {
+    if sortMethod == "" {
+        sortMethod = "new"
+    }
+    ranker, exists := rankers[sortMethod]
+    if !exists {
+        return postRanking{}, errUnknownSort
+    }
+
+    var since time.Time
+    if period != "" && period != "all" {
+        window, exists := listingPeriods[period]
+        if !exists {
+            return postRanking{}, errUnknownPeriod
+        }
+        since = now.Add(-window)
+    }
+    if aged, ok := ranker.(agedRanker); ok && since.Before(now.Add(-aged.MaxAge())) {
+        since = now.Add(-aged.MaxAge())
+    }
+
+    ranking := postRanking{listing: listing[*proto.Content]{key: func(content *proto.Content) cursor {
+        score, _ := s.scores.get(content.ContentId)
+        return cursor{ranker.Rank(content, score, now), content.ContentId}
+    }, at: now.UnixNano()}}
+    if !since.IsZero() {
+        ranking.since = since.Unix()
+    }
+    return ranking, nil
+}
+
+// rankingTime is the time to rank a listing at. The first page is ranked at
+// the current time and its cursors carry that time, so that later pages are
+// ranked at the same moment; otherwise ranks that change with time, like
+// rising, would move posts between pages.
+func rankingTime(after, before string) time.Time {
+    encoded := after
+    if encoded == "" {
+        encoded = before
+    }
+    if _, at, err := decodeCursor(encoded); err == nil && at != 0 {
+        return time.Unix(0, at)
+    }
+    return time.Now()
+}
+
+// lists reports whether a post belongs in the listing
+func (r postRanking) lists(content *proto.Content) bool {
+    return isVisible(content) && content.Timestamp >= r.since
+}
+
+// sorted ranks each post once and returns them in listing order
+func (r postRanking) sorted(items []*proto.Content) []*proto.Content {
+    keys := make(map[string]cursor, len(items))
+    for _, item := range items {
+        keys[item.ContentId] = r.key(item)
+    }
+    ranked := listing[*proto.Content]{key: func(content *proto.Content) cursor {
+        return keys[content.ContentId]
+    }}
+    return ranked.sorted(items)
+}
+
+// gatheredPosts collects the posts of a listing that spans several forums.
+// Posts are ranked as they are added, while their forum is locked, so that
+// sorting and paging them later reads no live post.
+type gatheredPosts struct {
+    ranking postRanking
+    keys    map[string]cursor
+    items   []*proto.Content
+}
+
+func (r postRanking) gather() *gatheredPosts {
+    return &gatheredPosts{ranking: r, keys: make(map[string]cursor)}
+}
+
+// add ranks a post if it belongs in the listing. Callers hold the lock of
+// the post's forum.
+func (g *gatheredPosts) add(content *proto.Content) {
+    if g.ranking.lists(content) {
+        g.keys[content.ContentId] = g.ranking.key(content)
+        g.items = append(g.items, content)
+    }
+}
+
+// paginate sorts the gathered posts by their ranks and pages them like
+// listing.paginate. The page holds live posts; see shownPosts.
+func (g *gatheredPosts) paginate(after, before string, limit int32) (page[*proto.Content], error) {
+    ranked := listing[*proto.Content]{key: func(content *proto.Content) cursor {
+        return g.keys[content.ContentId]
+    }, at: g.ranking.at}
+    return ranked.paginate(ranked.sorted(g.items), after, before, limit)
+}
+
+// rescore refreshes the cached scores of a post. Callers hold the lock of
+// the post's forum.
+func (s *SocialEngine) rescore(content *proto.Content) {
+    s.scores.put(content.ContentId, s.scorePost(content))
+}
+
+// rebuildScores scores every post after a restore
+func (s *SocialEngine) rebuildScores() {
+    s.scores.clear()
+    for _, forum := range s.forums.all() {
+        forum.mutex.RLock()
+        for _, content := range forum.Contents {
+            s.rescore(content)
+        }
+        forum.mutex.RUnlock()
+    }
+}
+
+// rankingProblem turns a ranking error into a message for the caller
+func rankingProblem(err error) string {
+    if errors.Is(err, errUnknownPeriod) {
+        return "Unknown time filter"
+    }
+    return "Unknown sort method"
+}
@@ -0,0 +1,98 @@
+// engine/registry.go
+package engine
+
+import (
+    "iter"
+    "sync"
+    "sync/atomic"
+    "reddit/proto"
+)
+
+// registry is a directory of items by ID that every shard may read and add
+// to without taking a lock. Only the directory is shared: each item is
+// guarded by the lock of the forum or user it belongs to.
+type registry[V any] struct {
+    items sync.Map
+    count atomic.Int64
+}
+
+func (r *registry[V]) get(id string) (V, bool) {
+    item, exists := r.items.Load(id)
+    if !exists {
+        var zero V
+        return zero, false
+    }
+    return item.(V), true
+}
+
+func (r *registry[V]) put(id string, item V) {
+    if _, replaced := r.items.Swap(id, item); !replaced {
+        r.count.Add(1)
+    }
+}
+
+func (r *registry[V]) remove(id string) {
+    if _, removed := r.items.LoadAndDelete(id); removed {
+        r.count.Add(-1)
+    }
+}
+
+// all ranges over the items. Items added or removed meanwhile may or may
+// not be seen.
+func (r *registry[V]) all() iter.Seq2[string, V] {
+    return func(yield func(string, V) bool) {
+        r.items.Range(func(id, item any) bool {
+            return yield(id.(string), item.(V))
+        })
+    }
+}
+
+func (r *registry[V]) len() int {
+    return int(r.count.Load())
+}
+
+// clear empties the registry. It is only used while nothing adds to it.
+func (r *registry[V]) clear() {
+    r.items.Clear()
+    r.count.Store(0)
+}
+
+// postIndex lists posts under a key, such as the original a share points at
+// or the file a media post carries. Lists are copied out, so readers never
+// see one being appended to.
+type postIndex struct {
+    mutex sync.RWMutex
+    posts map[string][]*proto.Content
+}
+
+func newPostIndex() *postIndex {
+    return &postIndex{posts: make(map[string][]*proto.Content)}
+}
+
+func (i *postIndex) add(key string, content *proto.Content) {
+    i.mutex.Lock()
+    defer i.mutex.Unlock()
+
+    i.posts[key] = append(i.posts[key], content)
+}
+
+func (i *postIndex) list(key string) []*proto.Content {
+    i.mutex.RLock()
+    defer i.mutex.RUnlock()
+
+    return append([]*proto.Content(nil), i.posts[key]...)
+}
+
+func (i *postIndex) has(key string) bool {
+    i.mutex.RLock()
+    defer i.mutex.RUnlock()
+
+    return len(i.posts[key]) > 0
+}
+
+func (i *postIndex) clear() {
+    i.mutex.Lock()
+    defer i.mutex.Unlock()
+
+    i.posts = make(map[string][]*proto.Content)
+}
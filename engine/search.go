@@ -0,0 +1,469 @@
+// engine/search.go
+package engine
+
+import (
+    "math"
+    "strings"
+    "sync"
+    "time"
+    "unicode"
+    "github.com/asynkron/protoactor-go/actor"
+    "reddit/proto"
+)
+
+// Kinds of searchable items
+const (
+    KindPost    = "post"
+    KindComment = "comment"
+    KindForum   = "forum"
+    KindUser    = "user"
+)
+
+// BM25 tuning parameters
+const (
+    bm25K1 = 1.2
+    bm25B  = 0.75
+)
+
+const snippetLength = 160
+
+// searchIndex is an inverted index over posts, comments, forums and users.
+// Every shard writes to it, so it has a lock of its own. Documents are
+// replaced rather than changed, so a matched document can be read once the
+// lock is released.
+type searchIndex struct {
+    mutex     sync.RWMutex
+    documents map[string]*searchDocument
+    postings  map[string]map[string]int
+    tokens    int
+}
+
+type searchDocument struct {
+    kind      string
+    forum     string
+    author    string
+    timestamp int64
+    tokens    []string
+}
+
+type searchQuery struct {
+    terms   []string
+    phrases [][]string
+}
+
+// searchHit is a document matching a query, with its BM25 score
+type searchHit struct {
+    id       string
+    document *searchDocument
+    score    float64
+}
+
+func newSearchIndex() *searchIndex {
+    return &searchIndex{
+        documents: make(map[string]*searchDocument),
+        postings:  make(map[string]map[string]int),
+    }
+}
+
+// tokenize splits text into lowercase words
+func tokenize(text string) []string {
+    return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+        return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+    })
+}
+
+// add indexes a document, replacing any earlier version of it
+func (i *searchIndex) add(id string, document *searchDocument) {
+    i.mutex.Lock()
+    defer i.mutex.Unlock()
+
+    i.drop(id)
+    i.documents[id] = document
+    i.tokens += len(document.tokens)
+    for _, token := range document.tokens {
+        if i.postings[token] == nil {
+            i.postings[token] = make(map[string]int)
+        }
+        i.postings[token][id]++
+    }
+}
+
+func (i *searchIndex) remove(id string) {
+    i.mutex.Lock()
+    defer i.mutex.Unlock()
+
+    i.drop(id)
+}
+
+func (i *searchIndex) drop(id string) {
+    document, exists := i.documents[id]
+    if !exists {
+        return
+    }
+
+    delete(i.documents, id)
+    i.tokens -= len(document.tokens)
+    for _, token := range document.tokens {
+        delete(i.postings[token], id)
+        if len(i.postings[token]) == 0 {
+            delete(i.postings, token)
+        }
+    }
+}
+
+// parseQuery splits a query into words, treating double-quoted runs of
+// words as phrases that must appear in order
+func parseQuery(text string) searchQuery {
+    var query searchQuery
+    for n, part := range strings.Split(text, `"`) {
+        words := tokenize(part)
+        query.terms = append(query.terms, words...)
+        // Odd parts sit between a pair of quotes
+        if n%2 == 1 && len(words) > 1 {
+            query.phrases = append(query.phrases, words)
+        }
+    }
+    return query
+}
+
+// clear empties the index before it is rebuilt
+func (i *searchIndex) clear() {
+    i.mutex.Lock()
+    defer i.mutex.Unlock()
+
+    i.documents = make(map[string]*searchDocument)
+    i.postings = make(map[string]map[string]int)
+    i.tokens = 0
+}
+
+// match returns the documents containing every query term and phrase,
+// scored with BM25
+func (i *searchIndex) match(query searchQuery) []searchHit {
+    i.mutex.RLock()
+    defer i.mutex.RUnlock()
+
+    var hits []searchHit
+    if len(query.terms) == 0 || len(i.documents) == 0 {
+        return hits
+    }
+
+    // Start from the rarest term to keep the candidate set small
+    rarest := query.terms[0]
+    for _, term := range query.terms {
+        if len(i.postings[term]) < len(i.postings[rarest]) {
+            rarest = term
+        }
+    }
+
+    total := float64(len(i.documents))
+    averageLength := float64(i.tokens) / total
+
+candidates:
+    for id := range i.postings[rarest] {
+        document := i.documents[id]
+        for _, phrase := range query.phrases {
+            if !containsPhrase(document.tokens, phrase) {
+                continue candidates
+            }
+        }
+
+        score := 0.0
+        for _, term := range query.terms {
+            frequency := float64(i.postings[term][id])
+            if frequency == 0 {
+                continue candidates
+            }
+            matching := float64(len(i.postings[term]))
+            idf := math.Log(1 + (total-matching+0.5)/(matching+0.5))
+            length := float64(len(document.tokens)) / averageLength
+            score += idf * frequency * (bm25K1 + 1) / (frequency + bm25K1*(1-bm25B+bm25B*length))
+        }
+        hits = append(hits, searchHit{id: id, document: document, score: score})
+    }
+    return hits
+}
+
+func containsPhrase(tokens []string, phrase []string) bool {
+    for start := 0; start+len(phrase) <= len(tokens); start++ {
+        matched := true
+        for offset, word := range phrase {
+            if tokens[start+offset] != word {
+                matched = false
+                break
+            }
+        }
+        if matched {
+            return true
+        }
+    }
+    return false
+}
+
+// The index follows every write to the store, so it stays in step with
+// commands as well as with replays. Callers hold the lock of the forum or
+// user being indexed.
+
+func (s *SocialEngine) reindexContent(content *proto.Content) {
+    if !isVisible(content) {
+        s.search.remove(content.ContentId)
+        return
+    }
+    s.search.add(content.ContentId, &searchDocument{
+        kind:      KindPost,
+        forum:     content.Subreddit,
+        author:    content.Creator,
+        timestamp: content.Timestamp,
+        tokens:    tokenize(content.Heading + " " + content.Body + " " + content.Domain),
+    })
+}
+
+// Comments are only searchable while their post is
+func (s *SocialEngine) reindexFeedback(feedback *proto.Feedback) {
+    content, exists := s.contents.get(feedback.ContentId)
+    if !exists || !isVisible(content) || feedback.IsDeleted || feedback.IsRemoved {
+        s.search.remove(feedback.FeedbackId)
+        return
+    }
+
+    s.search.add(feedback.FeedbackId, &searchDocument{
+        kind:      KindComment,
+        forum:     content.Subreddit,
+        author:    feedback.Creator,
+        timestamp: feedback.Timestamp,
+        tokens:    tokenize(feedback.Body),
+    })
+}
+
+func (s *SocialEngine) reindexForum(forum *ForumData) {
+    s.search.add(forumDocumentID(forum.Name), &searchDocument{
+        kind:      KindForum,
+        forum:     forum.Name,
+        author:    forum.Owner,
+        timestamp: forum.Created.Unix(),
+        tokens:    tokenize(forum.Name + " " + forum.Description),
+    })
+}
+
+func (s *SocialEngine) reindexUser(user *UserData) {
+    s.search.add(userDocumentID(user.Handle), &searchDocument{
+        kind:      KindUser,
+        author:    user.Handle,
+        timestamp: user.Created.Unix(),
+        tokens:    tokenize(user.Handle),
+    })
+}
+
+// Forums and users are keyed by name, so their documents are prefixed to
+// keep them apart from each other and from generated IDs
+func forumDocumentID(name string) string  { return "forum:" + name }
+func userDocumentID(handle string) string { return "user:" + handle }
+
+// unindexThread takes comments and their replies out of the index, once
+// their post is gone
+func (s *SocialEngine) unindexThread(feedbacks []*proto.Feedback) {
+    for _, feedback := range feedbacks {
+        s.search.remove(feedback.FeedbackId)
+        s.unindexThread(feedback.Replies)
+    }
+}
+
+// reindexThread indexes comments and their replies
+func (s *SocialEngine) reindexThread(feedbacks []*proto.Feedback) {
+    for _, feedback := range feedbacks {
+        s.reindexFeedback(feedback)
+        s.reindexThread(feedback.Replies)
+    }
+}
+
+// rebuildSearchIndex indexes the whole state after a restore
+func (s *SocialEngine) rebuildSearchIndex() {
+    s.search.clear()
+    for _, user := range s.users.all() {
+        user.mutex.RLock()
+        s.reindexUser(user)
+        user.mutex.RUnlock()
+    }
+    for _, forum := range s.forums.all() {
+        forum.mutex.RLock()
+        s.reindexForum(forum)
+        for _, content := range forum.Contents {
+            s.reindexContent(content)
+            s.reindexThread(content.Feedback)
+        }
+        forum.mutex.RUnlock()
+    }
+}
+
+func (s *SocialEngine) handleSearch(context actor.Context, msg *proto.Search) {
+    query := parseQuery(msg.Query)
+    if len(query.terms) == 0 {
+        context.Respond(&proto.SearchResults{
+            Success: false,
+            Message: "Search query cannot be empty",
+        })
+        return
+    }
+
+    switch msg.Kind {
+    case "", KindPost, KindComment, KindForum, KindUser:
+    default:
+        context.Respond(&proto.SearchResults{
+            Success: false,
+            Message: "Unknown search type",
+        })
+        return
+    }
+
+    var since int64
+    if msg.Period != "" && msg.Period != "all" {
+        period, exists := listingPeriods[msg.Period]
+        if !exists {
+            context.Respond(&proto.SearchResults{
+                Success: false,
+                Message: "Unknown time filter",
+            })
+            return
+        }
+        since = time.Now().Add(-period).Unix()
+    }
+
+    results := make([]*proto.SearchResult, 0)
+    for _, hit := range s.search.match(query) {
+        document := hit.document
+        if msg.Kind != "" && document.kind != msg.Kind {
+            continue
+        }
+        if msg.Forum != "" && document.forum != msg.Forum {
+            continue
+        }
+        if msg.Author != "" && document.author != msg.Author {
+            continue
+        }
+        if document.kind != KindForum && !s.canSearchForum(document.forum, msg.Viewer) {
+            continue
+        }
+        if document.timestamp < since {
+            continue
+        }
+
+        if result := s.searchResult(hit.id, document); result != nil {
+            result.Score = hit.score
+            results = append(results, result)
+        }
+    }
+
+    ranking := searchListing(msg.SortMethod)
+    result, err := ranking.paginate(ranking.sorted(results), msg.After, msg.Before, msg.Limit)
+    if err != nil {
+        context.Respond(&proto.SearchResults{
+            Success: false,
+            Message: "Invalid cursor",
+        })
+        return
+    }
+
+    context.Respond(&proto.SearchResults{
+        Success:    true,
+        Message:    "Search completed successfully",
+        Results:    result.items,
+        NextCursor: result.next,
+        PrevCursor: result.prev,
+    })
+}
+
+// canSearchForum reports whether posts and comments from a forum may appear
+// in a viewer's results
+func (s *SocialEngine) canSearchForum(name, viewer string) bool {
+    forum, exists := s.forums.get(name)
+    if !exists {
+        return true
+    }
+
+    forum.mutex.RLock()
+    defer forum.mutex.RUnlock()
+
+    return forum.canView(viewer)
+}
+
+// searchResult describes a matched document using the live item it points
+// at, read under the lock of its forum or user
+func (s *SocialEngine) searchResult(id string, document *searchDocument) *proto.SearchResult {
+    result := &proto.SearchResult{
+        Kind:      document.kind,
+        Forum:     document.forum,
+        Author:    document.author,
+        Timestamp: document.timestamp,
+    }
+
+    switch document.kind {
+    case KindPost:
+        content, forum, exists := s.lookupContent(id)
+        if !exists {
+            return nil
+        }
+        forum.mutex.RLock()
+        defer forum.mutex.RUnlock()
+
+        result.Id = content.ContentId
+        result.Title = content.Heading
+        result.Snippet = snippet(content.Body)
+        result.Points = content.Points
+    case KindComment:
+        feedback, _, forum, exists := s.lookupFeedback(id)
+        if !exists {
+            return nil
+        }
+        forum.mutex.RLock()
+        defer forum.mutex.RUnlock()
+
+        result.Id = feedback.FeedbackId
+        result.Snippet = snippet(feedback.Body)
+        result.Points = feedback.Points
+    case KindForum:
+        forum, exists := s.forums.get(document.forum)
+        if !exists {
+            return nil
+        }
+        forum.mutex.RLock()
+        defer forum.mutex.RUnlock()
+
+        result.Id = forum.Name
+        result.Title = forum.Name
+        result.Points = int32(len(forum.Members))
+    case KindUser:
+        user, exists := s.users.get(document.author)
+        if !exists {
+            return nil
+        }
+        user.mutex.RLock()
+        defer user.mutex.RUnlock()
+
+        result.Id = user.Handle
+        result.Title = user.Handle
+        result.Points = int32(user.Karma())
+    }
+    return result
+}
+
+// searchListing orders results by relevance unless sorted by recency or
+// points
+func searchListing(sortMethod string) listing[*proto.SearchResult] {
+    rank := func(result *proto.SearchResult) float64 { return result.Score }
+    switch sortMethod {
+    case "new":
+        rank = func(result *proto.SearchResult) float64 { return float64(result.Timestamp) }
+    case "top":
+        rank = func(result *proto.SearchResult) float64 { return float64(result.Points) }
+    }
+    return listing[*proto.SearchResult]{key: func(result *proto.SearchResult) cursor {
+        return cursor{rank(result), result.Kind + ":" + result.Id}
+    }}
+}
+
+func snippet(body string) string {
+    runes := []rune(body)
+    if len(runes) <= snippetLength {
+        return body
+    }
+    return string(runes[:snippetLength]) + "..."
+}
@@ -0,0 +1,193 @@
+// engine/search_test.go
+package engine
+
+import (
+    "fmt"
+    "testing"
+    "reddit/proto"
+)
+
+func document(kind string, text string) *searchDocument {
+    return &searchDocument{kind: kind, tokens: tokenize(text)}
+}
+
+func hitIDs(hits []searchHit) map[string]float64 {
+    ids := make(map[string]float64, len(hits))
+    for _, hit := range hits {
+        ids[hit.id] = hit.score
+    }
+    return ids
+}
+
+func TestTokenize(t *testing.T) {
+    got := tokenize("Go 1.22: range-over-func, ÜBER fast!")
+    want := []string{"go", "1", "22", "range", "over", "func", "über", "fast"}
+    if fmt.Sprint(got) != fmt.Sprint(want) {
+        t.Errorf("tokenize = %v, want %v", got, want)
+    }
+}
+
+func TestParseQuery(t *testing.T) {
+    query := parseQuery(`rust "borrow checker" fights "x"`)
+    if want := []string{"rust", "borrow", "checker", "fights", "x"}; fmt.Sprint(query.terms) != fmt.Sprint(want) {
+        t.Errorf("terms = %v, want %v", query.terms, want)
+    }
+    // A quoted single word is just a term
+    if want := [][]string{{"borrow", "checker"}}; fmt.Sprint(query.phrases) != fmt.Sprint(want) {
+        t.Errorf("phrases = %v, want %v", query.phrases, want)
+    }
+
+    // An unclosed quote still makes a phrase of the rest
+    query = parseQuery(`"garbage collector`)
+    if want := [][]string{{"garbage", "collector"}}; fmt.Sprint(query.phrases) != fmt.Sprint(want) {
+        t.Errorf("unclosed phrases = %v, want %v", query.phrases, want)
+    }
+}
+
+func TestMatchRequiresEveryTerm(t *testing.T) {
+    index := newSearchIndex()
+    index.add("a", document(KindPost, "goroutines and channels"))
+    index.add("b", document(KindPost, "channels in rust"))
+    index.add("c", document(KindPost, "goroutines leak"))
+
+    hits := hitIDs(index.match(parseQuery("goroutines channels")))
+    if len(hits) != 1 || hits["a"] == 0 {
+        t.Errorf("hits = %v, want only a", hits)
+    }
+    if hits := index.match(parseQuery("haskell")); len(hits) != 0 {
+        t.Errorf("unknown term matched %d documents", len(hits))
+    }
+}
+
+func TestMatchRanksWithBM25(t *testing.T) {
+    index := newSearchIndex()
+    index.add("often", document(KindPost, "generics generics generics in go"))
+    index.add("once", document(KindPost, "generics in go"))
+    index.add("long", document(KindPost, "generics in go with a much longer body that rambles on about other things"))
+    index.add("other", document(KindPost, "nothing relevant here"))
+
+    hits := hitIDs(index.match(parseQuery("generics")))
+    if len(hits) != 3 {
+        t.Fatalf("hits = %v, want three", hits)
+    }
+    if hits["often"] <= hits["once"] {
+        t.Errorf("repeated term scored %f, not above %f", hits["often"], hits["once"])
+    }
+    if hits["once"] <= hits["long"] {
+        t.Errorf("short document scored %f, not above longer %f", hits["once"], hits["long"])
+    }
+
+    // Rarer terms weigh more
+    rare := hitIDs(index.match(parseQuery("rambles")))
+    if rare["long"] <= hits["long"] {
+        t.Errorf("rare term scored %f, not above common %f", rare["long"], hits["long"])
+    }
+}
+
+func TestMatchFiltersPhrases(t *testing.T) {
+    index := newSearchIndex()
+    index.add("ordered", document(KindComment, "the borrow checker won"))
+    index.add("scattered", document(KindComment, "checker of what you borrow"))
+
+    hits := hitIDs(index.match(parseQuery(`"borrow checker"`)))
+    if len(hits) != 1 || hits["ordered"] == 0 {
+        t.Errorf("phrase hits = %v, want only ordered", hits)
+    }
+    if hits := index.match(parseQuery("borrow checker")); len(hits) != 2 {
+        t.Errorf("unquoted query matched %d documents, want 2", len(hits))
+    }
+}
+
+func TestIndexReplaceRemoveAndClear(t *testing.T) {
+    index := newSearchIndex()
+    index.add("a", document(KindPost, "first draft"))
+    index.add("b", document(KindPost, "another draft"))
+
+    index.add("a", document(KindPost, "final version"))
+    if hits := hitIDs(index.match(parseQuery("first"))); len(hits) != 0 {
+        t.Errorf("replaced text still matches: %v", hits)
+    }
+    if hits := hitIDs(index.match(parseQuery("final"))); hits["a"] == 0 {
+        t.Error("new text does not match")
+    }
+    if index.tokens != 4 {
+        t.Errorf("index counts %d tokens, want 4", index.tokens)
+    }
+
+    index.remove("b")
+    index.remove("missing")
+    if _, exists := index.postings["draft"]; exists {
+        t.Error("removing the last document left its postings behind")
+    }
+    if index.tokens != 2 {
+        t.Errorf("index counts %d tokens after removal, want 2", index.tokens)
+    }
+
+    index.clear()
+    if len(index.documents) != 0 || len(index.postings) != 0 || index.tokens != 0 {
+        t.Error("clear left documents behind")
+    }
+    if hits := index.match(parseQuery("final")); len(hits) != 0 {
+        t.Errorf("cleared index matched %d documents", len(hits))
+    }
+}
+
+func TestSearchHidesPrivateForums(t *testing.T) {
+    s := newTestEngine()
+    run(s, &proto.OnboardUser{UserHandle: "alice"})
+    run(s, &proto.OnboardUser{UserHandle: "bob"})
+    run(s, &proto.CreateForum{Name: "open", UserHandle: "alice"})
+    run(s, &proto.CreateForum{Name: "secret", UserHandle: "alice", Settings: &proto.ForumSettings{Visibility: VisibilityPrivate}})
+    run(s, &proto.CreateContent{UserHandle: "alice", Subreddit: "open", Heading: "Sourdough tips", Body: "Feed the starter"})
+    run(s, &proto.CreateContent{UserHandle: "alice", Subreddit: "secret", Heading: "Sourdough secrets", Body: "Hidden"})
+
+    search := func(viewer string) []string {
+        response := query(s, &proto.Search{Query: "sourdough", Kind: KindPost, Viewer: viewer}).(*proto.SearchResults)
+        if !response.Success {
+            t.Fatalf("search failed: %s", response.Message)
+        }
+        var forums []string
+        for _, result := range response.Results {
+            forums = append(forums, result.Forum)
+        }
+        return forums
+    }
+    if got := search("bob"); fmt.Sprint(got) != "[open]" {
+        t.Errorf("bob found posts in %v, want [open]", got)
+    }
+    if got := search("alice"); len(got) != 2 {
+        t.Errorf("alice found posts in %v, want both forums", got)
+    }
+}
+
+func TestSearchRejectsBadRequests(t *testing.T) {
+    s := newTestEngine()
+    for _, msg := range []*proto.Search{
+        {Query: "  !! "},
+        {Query: "go", Kind: "video"},
+        {Query: "go", Period: "decade"},
+    } {
+        if response := query(s, msg).(*proto.SearchResults); response.Success {
+            t.Errorf("search %v succeeded", msg)
+        }
+    }
+}
+
+func TestRebuildSearchIndex(t *testing.T) {
+    s := newTestEngine()
+    run(s, &proto.OnboardUser{UserHandle: "alice"})
+    run(s, &proto.CreateForum{Name: "baking", UserHandle: "alice"})
+    response, _ := run(s, &proto.CreateContent{UserHandle: "alice", Subreddit: "baking", Heading: "Rye bread", Body: "Dense"})
+    contentId := response.(*proto.CreateContentResponse).ContentId
+    run(s, &proto.CreateFeedback{UserHandle: "alice", ContentId: contentId, Body: "Try caraway"})
+
+    want := len(s.search.documents)
+    s.search.clear()
+    s.rebuildSearchIndex()
+    if got := len(s.search.documents); got != want || want != 4 {
+        t.Errorf("rebuilt index has %d documents, had %d, want 4", got, want)
+    }
+    if hits := s.search.match(parseQuery("caraway")); len(hits) != 1 || hits[0].document.kind != KindComment {
+        t.Errorf("comment not found after rebuild: %v", hits)
+    }
+}
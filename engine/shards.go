@@ -0,0 +1,405 @@
+// engine/shards.go
+package engine
+
+import (
+    "log"
+    "strings"
+    "time"
+    "github.com/asynkron/protoactor-go/actor"
+    "github.com/asynkron/protoactor-go/cluster"
+    "reddit/proto"
+)
+
+// shardIdleTimeout is how long a shard may go without a message before it
+// is passivated
+const shardIdleTimeout = 2 * time.Minute
+
+// Supervision of shards: a shard that panics is restarted, and gives up
+// after too many failures in a short time
+var shardSupervisor = actor.NewOneForOneStrategy(10, 10*time.Second, actor.DefaultDecider)
+
+// ShardProps returns the props for the engine actor. The engine journals
+// every command in the order it arrives and supervises one child actor per
+// forum and per user. A shard applies the commands that change only its
+// forum or user, and serves queries about it, so commands and queries for
+// different forums and users run at the same time. Commands that change
+// several forums or users at once are applied by the engine itself once the
+// shards have applied the commands journaled before them; see inOrder.
+func (s *SocialEngine) ShardProps() *actor.Props {
+    return actor.PropsFromProducer(func() actor.Actor {
+        return s
+    }, actor.WithSupervisor(shardSupervisor))
+}
+
+// shard applies commands and serves queries for one forum or user on behalf
+// of the engine. Its mailbox keeps the forum's or user's commands in journal
+// order; the locks of the forums and users it reads keep it apart from other
+// shards. In a cluster shards are grains that only serve queries, and stop
+// themselves when idle.
+type shard struct {
+    engine    *SocialEngine
+    key       string
+    clustered bool
+}
+
+// partitionCommand is a journaled command handed to the shard that applies
+// it, which answers the sender
+type partitionCommand struct {
+    entry   *proto.JournalEntry
+    message interface{}
+    sender  *actor.PID
+}
+
+// commandDone tells the engine that a shard has applied a command
+type commandDone struct {
+    key string
+}
+
+// compactState asks the engine to write a snapshot once the shards are idle
+type compactState struct{}
+
+// heldMessage is a message the engine puts off until the shards have
+// applied the commands handed to them, along with everything that arrives
+// after it
+type heldMessage struct {
+    message interface{}
+    sender  *actor.PID
+}
+
+// unroutedQuery hands a query back to the engine when its grain could not be
+// found
+type unroutedQuery struct {
+    message interface{}
+    sender  *actor.PID
+}
+
+// replyContext answers the sender of a query the engine handles after it
+// was received
+type replyContext struct {
+    actor.Context
+    sender *actor.PID
+}
+
+func (c replyContext) Sender() *actor.PID {
+    return c.sender
+}
+
+func (c replyContext) Respond(response interface{}) {
+    if c.sender != nil {
+        c.Context.Send(c.sender, response)
+    }
+}
+
+// passivateShard asks the engine to stop an idle shard
+type passivateShard struct {
+    key string
+    pid *actor.PID
+}
+
+func (a *shard) Receive(context actor.Context) {
+    switch msg := context.Message().(type) {
+    case *actor.Started:
+        context.SetReceiveTimeout(shardIdleTimeout)
+    case *actor.ReceiveTimeout:
+        if a.clustered {
+            context.Stop(context.Self())
+            return
+        }
+        // The engine stops the shard so that no message is routed to it
+        // after it is gone
+        context.Send(context.Parent(), &passivateShard{key: a.key, pid: context.Self()})
+    case *actor.Stopping, *actor.Stopped, *actor.Restarting, *cluster.ClusterInit:
+    case *partitionCommand:
+        a.apply(context, msg)
+    default:
+        a.engine.dispatch(context, msg)
+    }
+}
+
+// apply handles a command the engine has journaled. The engine is told once
+// it is done, even if handling it fails.
+func (a *shard) apply(context actor.Context, command *partitionCommand) {
+    defer context.Send(context.Parent(), &commandDone{key: a.key})
+    a.engine.handle(replyContext{Context: context, sender: command.sender}, command.entry, command.message, false)
+}
+
+// shardKey names the shard that serves a query. Commands and queries that
+// span the whole site are handled by the engine itself.
+func (s *SocialEngine) shardKey(message interface{}) (string, bool) {
+    switch msg := message.(type) {
+    case *proto.GetForumDetails:
+        return GrainForum + "/" + msg.ForumName, true
+    case *proto.GetPost:
+        return s.contentShard(msg.ContentId)
+    case *proto.GetCrossposts:
+        return s.contentShard(msg.ContentId)
+    case *proto.GetCommentTree:
+        if msg.FeedbackId != "" {
+            return s.feedbackShard(msg.FeedbackId)
+        }
+        return s.contentShard(msg.ContentId)
+    case *proto.GetFeedback:
+        return s.feedbackShard(msg.FeedbackId)
+    case *proto.GetFeed:
+        return GrainUser + "/" + msg.UserHandle, true
+    case *proto.GetChats:
+        return GrainUser + "/" + msg.UserHandle, true
+    case *proto.GetConversations:
+        return GrainUser + "/" + msg.UserHandle, true
+    case *proto.GetConversation:
+        return GrainUser + "/" + msg.UserHandle, true
+    case *proto.GetUserProfile:
+        return GrainUser + "/" + msg.UserHandle, true
+    case *proto.GetNotifications:
+        return GrainUser + "/" + msg.UserHandle, true
+    case *proto.CheckPassword:
+        return GrainUser + "/" + msg.UserHandle, true
+    }
+    return "", false
+}
+
+// partition names the shard that applies a command. Commands that change
+// one forum and its posts, or one user and their inbox, are applied by its
+// shard. The rest change several forums or users, or read state that other
+// shards change, so the engine applies them itself.
+func (s *SocialEngine) partition(message interface{}) (string, bool) {
+    switch msg := message.(type) {
+    case *proto.CreateContent:
+        return s.postShard(msg)
+    case *proto.CreateFeedback:
+        if msg.ContentId == "" && msg.ParentId != "" {
+            return s.feedbackShard(msg.ParentId)
+        }
+        return s.contentShard(msg.ContentId)
+    case *proto.Reaction:
+        if msg.IsContent {
+            return s.contentShard(msg.ItemId)
+        }
+        return s.feedbackShard(msg.ItemId)
+    case *proto.EditContent:
+        return s.contentShard(msg.ContentId)
+    case *proto.LockContent:
+        return s.contentShard(msg.ContentId)
+    case *proto.EditFeedback:
+        return s.feedbackShard(msg.FeedbackId)
+    case *proto.DeleteFeedback:
+        return s.feedbackShard(msg.FeedbackId)
+    case *proto.RemoveFeedback:
+        return s.feedbackShard(msg.FeedbackId)
+    case *proto.JoinForum:
+        return GrainForum + "/" + msg.Subreddit, true
+    case *proto.LeaveForum:
+        return GrainForum + "/" + msg.Subreddit, true
+    case *proto.AppointModerator:
+        return GrainForum + "/" + msg.Subreddit, true
+    case *proto.RemoveModerator:
+        return GrainForum + "/" + msg.Subreddit, true
+    case *proto.BanUser:
+        return GrainForum + "/" + msg.Subreddit, true
+    case *proto.UnbanUser:
+        return GrainForum + "/" + msg.Subreddit, true
+    case *proto.InviteToForum:
+        return GrainForum + "/" + msg.Subreddit, true
+    case *proto.RequestToJoin:
+        return GrainForum + "/" + msg.Subreddit, true
+    case *proto.ReviewJoinRequest:
+        return GrainForum + "/" + msg.Subreddit, true
+    case *proto.DirectChat:
+        return GrainUser + "/" + msg.Receiver, true
+    case *proto.MarkRead:
+        return GrainUser + "/" + msg.UserHandle, true
+    case *proto.ActivityStatus:
+        return GrainUser + "/" + msg.UserHandle, true
+    }
+    return "", false
+}
+
+// postShard names the shard of the forum a post is submitted to. Shares
+// link two forums, and posts to a forum with a karma requirement read the
+// author's karma, which votes in other forums change; the engine applies
+// both itself.
+func (s *SocialEngine) postShard(msg *proto.CreateContent) (string, bool) {
+    forum, exists := s.forums.get(msg.Subreddit)
+    if msg.IsShare || !exists {
+        return "", false
+    }
+
+    forum.mutex.RLock()
+    defer forum.mutex.RUnlock()
+
+    if forum.MinKarma > 0 {
+        return "", false
+    }
+    return GrainForum + "/" + forum.Name, true
+}
+
+func (s *SocialEngine) contentShard(contentId string) (string, bool) {
+    content, exists := s.contents.get(contentId)
+    if !exists {
+        return "", false
+    }
+    return GrainForum + "/" + content.Subreddit, true
+}
+
+func (s *SocialEngine) feedbackShard(feedbackId string) (string, bool) {
+    feedback, exists := s.feedbacks.get(feedbackId)
+    if !exists {
+        return "", false
+    }
+    return s.contentShard(feedback.ContentId)
+}
+
+// inOrder journals a command and has it applied, or handles a message that
+// needs the state to itself. Commands for a single forum or user go to its
+// shard. Anything else waits until the shards have applied the commands
+// handed to them, and so does everything arriving while something waits, so
+// that each command sees the effects of all commands journaled before it.
+func (s *SocialEngine) inOrder(context actor.Context, message interface{}, sender *actor.PID) {
+    if len(s.held) > 0 || !s.ready(message) {
+        s.held = append(s.held, heldMessage{message: message, sender: sender})
+        return
+    }
+    s.run(context, message, sender)
+    s.compactIfDue(context)
+}
+
+// ready reports whether a message can be handled without waiting for the
+// shards
+func (s *SocialEngine) ready(message interface{}) bool {
+    if _, partitioned := s.partition(message); partitioned {
+        return true
+    }
+    return s.inflight == 0
+}
+
+func (s *SocialEngine) run(context actor.Context, message interface{}, sender *actor.PID) {
+    reply := replyContext{Context: context, sender: sender}
+    switch msg := message.(type) {
+    case *compactState:
+        s.snapshot()
+        return
+    case *proto.Follow:
+        s.handleFollow(reply, msg)
+        return
+    }
+
+    entry := s.record(message)
+    s.replicate(context, entry)
+    if key, partitioned := s.partition(message); partitioned {
+        s.inflight++
+        s.busy[key]++
+        context.Send(s.shardFor(context, key), &partitionCommand{
+            entry:   entry,
+            message: message,
+            sender:  sender,
+        })
+        return
+    }
+    s.handle(reply, entry, message, false)
+}
+
+// commandDone notes that a shard applied a command, and handles what was
+// waiting for the shards once they are all idle
+func (s *SocialEngine) commandDone(context actor.Context, msg *commandDone) {
+    if s.busy[msg.key] > 0 {
+        s.inflight--
+        if s.busy[msg.key]--; s.busy[msg.key] == 0 {
+            delete(s.busy, msg.key)
+        }
+    }
+    s.release(context)
+    s.compactIfDue(context)
+}
+
+// release handles held messages in the order they arrived, until one has to
+// wait for the shards again
+func (s *SocialEngine) release(context actor.Context) {
+    for len(s.held) > 0 && s.ready(s.held[0].message) {
+        next := s.held[0]
+        s.held = s.held[1:]
+        s.run(context, next.message, next.sender)
+    }
+}
+
+// compactIfDue queues a snapshot once enough records have been stored. It is
+// written once the shards are idle, since a snapshot replaces the records
+// they append; Replay writes its own.
+func (s *SocialEngine) compactIfDue(context actor.Context) {
+    if s.compactDue.CompareAndSwap(true, false) {
+        s.inOrder(context, &compactState{}, nil)
+    }
+}
+
+// route forwards a query to its shard. It only runs on the engine actor,
+// which owns the shard table. In a cluster the query goes to the forum or
+// user grain instead, wherever it is placed.
+func (s *SocialEngine) route(context actor.Context, message interface{}) bool {
+    key, routed := s.shardKey(message)
+    if !routed {
+        return false
+    }
+
+    if s.cluster != nil {
+        s.routeToGrain(context, key, message)
+        return true
+    }
+
+    context.Forward(s.shardFor(context, key))
+    return true
+}
+
+// shardFor returns the shard for a key, spawning it on demand
+func (s *SocialEngine) shardFor(context actor.Context, key string) *actor.PID {
+    pid, exists := s.shards[key]
+    if !exists {
+        props := actor.PropsFromProducer(func() actor.Actor {
+            return &shard{engine: s, key: key}
+        })
+        pid = context.Spawn(props)
+        s.shards[key] = pid
+        log.Printf("Spawned shard %s", key)
+    }
+    return pid
+}
+
+// routeToGrain sends a query to its grain on behalf of the sender. Looking
+// up a grain may wait on other members, so it is done off the actor; a query
+// whose grain is not found is handed back to the engine to answer itself.
+func (s *SocialEngine) routeToGrain(context actor.Context, key string, message interface{}) {
+    kind, identity, _ := strings.Cut(key, "/")
+    members, system, self, sender := s.cluster, context.ActorSystem(), context.Self(), context.Sender()
+    go func() {
+        pid := members.Get(identity, kind)
+        if pid == nil {
+            system.Root.Send(self, &unroutedQuery{message: message, sender: sender})
+            return
+        }
+        system.Root.RequestWithCustomSender(pid, message, sender)
+    }()
+}
+
+// passivate stops an idle shard. Poisoning lets the shard finish the
+// queries already forwarded to it. A shard with commands still to apply is
+// kept, so that a new shard for the same key never applies commands
+// alongside it; it asks again once it is idle.
+func (s *SocialEngine) passivate(context actor.Context, msg *passivateShard) {
+    if pid, exists := s.shards[msg.key]; exists && pid.Id == msg.pid.Id && s.busy[msg.key] == 0 {
+        delete(s.shards, msg.key)
+        context.Poison(pid)
+    }
+}
+
+// shardTerminated forgets a shard that stopped on its own, along with the
+// commands it will no longer report as applied
+func (s *SocialEngine) shardTerminated(context actor.Context, who *actor.PID) bool {
+    for key, pid := range s.shards {
+        if pid.Id == who.Id {
+            delete(s.shards, key)
+            s.inflight -= s.busy[key]
+            delete(s.busy, key)
+            s.release(context)
+            return true
+        }
+    }
+    return false
+}
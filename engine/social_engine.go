@@ -3,45 +3,115 @@ package engine
 
 import (
     "log"
-    "sort"
     "sync"
+    "sync/atomic"
     "time"
     "github.com/asynkron/protoactor-go/actor"
+    "github.com/asynkron/protoactor-go/cluster"
+    protobuf "google.golang.org/protobuf/proto"
+    "reddit/auth"
     "reddit/proto"
-    "reddit/utils"
+    "reddit/storage"
 )
 
+// SocialEngine holds the state of the site. Each forum, with its posts and
+// comments, and each user, with their inbox and notifications, is guarded
+// by a lock of its own, and the engine's directories of forums, users,
+// posts and comments are shared without one. Commands for one forum or user
+// are applied by that forum's or user's shard, so commands for different
+// forums and users run side by side; see ShardProps.
 type SocialEngine struct {
-    users       map[string]*UserData
-    forums      map[string]*ForumData
-    contents    map[string]*proto.Content
-    feedbacks   map[string]*proto.Feedback
-    chats       map[string][]*proto.DirectChat
-    mutex       sync.RWMutex
+    users       registry[*UserData]
+    forums      registry[*ForumData]
+    contents    registry[*proto.Content]
+    feedbacks   registry[*proto.Feedback]
+    scores      registry[PostScore]
+    quarantined registry[string]
+    store       storage.Store
+    pending     atomic.Int64
+    compactDue  atomic.Bool
+    journal     storage.Journal
+    sequence    int64
+    search      *searchIndex
+    crossposts  *postIndex
+    media       *postIndex
+    ledger      *voteLedger
+    admins      map[string]bool
+    subscribers *subscriberList
+    self        *actor.PID
+    shards      map[string]*actor.PID
+    busy        map[string]int
+    inflight    int
+    held        []heldMessage
+    cluster     *cluster.Cluster
+    leader      *actor.PID
+    followers   map[string]*actor.PID
 }
 
+// UserData is a user's account. The user's lock guards it along with the
+// user's inbox, conversations and notifications.
 type UserData struct {
-    Handle     string
-    Points     int
-    Forums     map[string]bool
-    IsOnline   bool
-    LastSeen   time.Time
+    Handle       string
+    PasswordHash string
+    Points       int
+    PostKarma    int
+    CommentKarma int
+    Forums       map[string]bool
+    IsOnline     bool
+    LastSeen     time.Time
+    Created      time.Time
+    VoteFlag     string
+    FlaggedAt    time.Time
+
+    mutex         sync.RWMutex
+    inbox         []*proto.DirectChat
+    threads       map[string][]*proto.DirectChat
+    notifications []*proto.Notification
 }
 
+// ForumData is a forum. The forum's lock guards it along with its posts and
+// their comments.
 type ForumData struct {
     Name       string
+    Owner      string
+    Creator    string
+    Moderators map[string]bool
     Members    map[string]bool
+    Banned     map[string]string
     Contents   []*proto.Content
     Created    time.Time
+
+    // Access to private and restricted forums
+    Invited      map[string]bool
+    Approved     map[string]bool
+    JoinRequests map[string]*proto.JoinRequest
+
+    // Settings the owner can change
+    Description        string
+    Rules              []string
+    Visibility         string
+    TextOnly           bool
+    LinksDisabled      bool
+    MinKarma           int
+    ExcludeFromPopular bool
+
+    mutex sync.RWMutex
 }
 
-func NewSocialEngine() *SocialEngine {
+func NewSocialEngine(store storage.Store, journal storage.Journal) *SocialEngine {
     return &SocialEngine{
-        users:      make(map[string]*UserData),
-        forums:     make(map[string]*ForumData),
-        contents:   make(map[string]*proto.Content),
-        feedbacks:  make(map[string]*proto.Feedback),
-        chats:      make(map[string][]*proto.DirectChat),
+        store:       store,
+        journal:     journal,
+        sequence:    journal.LastSequence(),
+        search:      newSearchIndex(),
+        crossposts:  newPostIndex(),
+        media:       newPostIndex(),
+        ledger:      newVoteLedger(),
+        admins:      make(map[string]bool),
+        subscribers: newSubscriberList(),
+        shards:      make(map[string]*actor.PID),
+        busy:        make(map[string]int),
+        followers:   make(map[string]*actor.PID),
     }
 }
 
@@ -49,6 +119,62 @@ func (s *SocialEngine) Receive(context actor.Context) {
     switch msg := context.Message().(type) {
     case *actor.Started:
         log.Println("Social engine started")
+        s.self = context.Self()
+        if s.cluster != nil {
+            s.follow(context)
+        }
+    case *actor.Stopping:
+        // A snapshot replaces the store's log, so it is only taken once no
+        // shard can still be appending to it
+        if s.inflight == 0 {
+            s.Snapshot()
+        } else {
+            log.Printf("Skipping final snapshot with %d commands in flight", s.inflight)
+        }
+    case *actor.Terminated:
+        switch {
+        case s.shardTerminated(context, msg.Who):
+        case s.replicaTerminated(context, msg.Who):
+        default:
+            s.handleSubscriberTerminated(msg)
+        }
+    case *passivateShard:
+        s.passivate(context, msg)
+    case *commandDone:
+        s.commandDone(context, msg)
+    case *followLeader:
+        s.follow(context)
+    case *leaderFound:
+        s.handleLeaderFound(context, msg)
+    case *unroutedQuery:
+        s.dispatch(replyContext{Context: context, sender: msg.sender}, msg.message)
+    case *proto.SnapshotChunk:
+        if s.cluster != nil {
+            s.applySnapshotChunk(context, msg)
+        }
+    case *proto.JournalEntry:
+        // Only replicas take journal entries as messages; a single engine
+        // replays its journal at startup, see Replay
+        if s.cluster != nil {
+            s.applyReplicated(context, msg)
+        }
+    case *proto.Follow:
+        s.inOrder(context, msg, context.Sender())
+    default:
+        if isCommand(msg) && s.cluster != nil {
+            s.forwardCommand(context)
+            return
+        }
+        if isCommand(msg) {
+            s.inOrder(context, msg, context.Sender())
+        } else if !s.route(context, msg) {
+            s.dispatch(context, msg)
+        }
+    }
+}
+
+func (s *SocialEngine) dispatch(context actor.Context, message interface{}) {
+    switch msg := message.(type) {
     case *proto.OnboardUser:
         s.handleOnboarding(context, msg)
     case *proto.CreateForum:
@@ -65,23 +191,90 @@ func (s *SocialEngine) Receive(context actor.Context) {
         s.handleReaction(context, msg)
     case *proto.GetFeed:
         s.handleFeedRequest(context, msg)
+    case *proto.GetFrontPage:
+        s.handleGetFrontPage(context, msg)
     case *proto.GetPost:
         s.handleGetPost(context, msg)
+    case *proto.GetCrossposts:
+        s.handleGetCrossposts(context, msg)
+    case *proto.GetMedia:
+        s.handleGetMedia(context, msg)
+    case *proto.GetFeedback:
+        s.handleGetFeedback(context, msg)
+    case *proto.GetCommentTree:
+        s.handleGetCommentTree(context, msg)
     case *proto.GetForumDetails:
         s.handleGetForumDetails(context, msg)
+    case *proto.UpdateForumSettings:
+        s.handleUpdateForumSettings(context, msg)
+    case *proto.InviteToForum:
+        s.handleInviteToForum(context, msg)
+    case *proto.RequestToJoin:
+        s.handleRequestToJoin(context, msg)
+    case *proto.ReviewJoinRequest:
+        s.handleReviewJoinRequest(context, msg)
+    case *proto.GetJoinRequests:
+        s.handleGetJoinRequests(context, msg)
+    case *proto.GetFlaggedVoters:
+        s.handleGetFlaggedVoters(context, msg)
+    case *proto.ClearVoteFlag:
+        s.handleClearVoteFlag(context, msg)
+    case *proto.FlagVoter:
+        s.handleFlagVoter(context, msg)
     case *proto.DirectChat:
         s.handleChatDelivery(context, msg)
     case *proto.GetChats:
         s.handleChatRetrieval(context, msg)
+    case *proto.GetConversations:
+        s.handleGetConversations(context, msg)
+    case *proto.GetConversation:
+        s.handleGetConversation(context, msg)
+    case *proto.MarkRead:
+        s.handleMarkRead(context, msg)
+    case *proto.GetNotifications:
+        s.handleGetNotifications(context, msg)
+    case *proto.MarkNotificationsRead:
+        s.handleMarkNotificationsRead(context, msg)
     case *proto.ActivityStatus:
         s.handleActivityUpdate(context, msg)
+    case *proto.CheckPassword:
+        s.handleCheckPassword(context, msg)
+    case *proto.GetUserProfile:
+        s.handleGetUserProfile(context, msg)
+    case *proto.Search:
+        s.handleSearch(context, msg)
+    case *proto.Subscribe:
+        s.handleSubscribe(context, msg)
+    case *proto.Unsubscribe:
+        s.handleUnsubscribe(context, msg)
+    case *proto.Follow:
+        s.handleFollow(context, msg)
+    case *proto.AppointModerator:
+        s.handleAppointModerator(context, msg)
+    case *proto.RemoveModerator:
+        s.handleRemoveModerator(context, msg)
+    case *proto.BanUser:
+        s.handleBan(context, msg)
+    case *proto.UnbanUser:
+        s.handleUnban(context, msg)
+    case *proto.RemoveContent:
+        s.handleRemoveContent(context, msg)
+    case *proto.RemoveFeedback:
+        s.handleRemoveFeedback(context, msg)
+    case *proto.LockContent:
+        s.handleLockContent(context, msg)
+    case *proto.EditContent:
+        s.handleEditContent(context, msg)
+    case *proto.DeleteContent:
+        s.handleDeleteContent(context, msg)
+    case *proto.EditFeedback:
+        s.handleEditFeedback(context, msg)
+    case *proto.DeleteFeedback:
+        s.handleDeleteFeedback(context, msg)
     }
 }
 
 func (s *SocialEngine) handleOnboarding(context actor.Context, msg *proto.OnboardUser) {
-    s.mutex.Lock()
-    defer s.mutex.Unlock()
-
     if msg.UserHandle == "" {
         context.Respond(&proto.OnboardUserResponse{
             Success: false,
@@ -90,7 +283,7 @@ func (s *SocialEngine) handleOnboarding(context actor.Context, msg *proto.Onboar
         return
     }
 
-    if _, exists := s.users[msg.UserHandle]; exists {
+    if _, exists := s.users.get(msg.UserHandle); exists {
         context.Respond(&proto.OnboardUserResponse{
             Success: false,
             Message: "Username already exists",
@@ -98,13 +291,17 @@ func (s *SocialEngine) handleOnboarding(context actor.Context, msg *proto.Onboar
         return
     }
 
-    s.users[msg.UserHandle] = &UserData{
-        Handle:    msg.UserHandle,
-        Points:    0,
-        Forums:    make(map[string]bool),
-        IsOnline:  true,
-        LastSeen:  time.Now(),
+    user := &UserData{
+        Handle:       msg.UserHandle,
+        PasswordHash: msg.PasswordHash,
+        Points:       0,
+        Forums:       make(map[string]bool),
+        IsOnline:     true,
+        LastSeen:     s.now(context),
+        Created:      s.now(context),
     }
+    s.persistUser(user)
+    s.users.put(msg.UserHandle, user)
 
     log.Printf("New user onboarded: %s", msg.UserHandle)
     context.Respond(&proto.OnboardUserResponse{
@@ -114,9 +311,6 @@ func (s *SocialEngine) handleOnboarding(context actor.Context, msg *proto.Onboar
 }
 
 func (s *SocialEngine) handleForumCreation(context actor.Context, msg *proto.CreateForum) {
-    s.mutex.Lock()
-    defer s.mutex.Unlock()
-
     if msg.Name == "" {
         context.Respond(&proto.CreateForumResponse{
             Success: false,
@@ -125,7 +319,7 @@ func (s *SocialEngine) handleForumCreation(context actor.Context, msg *proto.Cre
         return
     }
 
-    if _, exists := s.forums[msg.Name]; exists {
+    if _, exists := s.forums.get(msg.Name); exists {
         context.Respond(&proto.CreateForumResponse{
             Success: false,
             Message: "Forum already exists",
@@ -133,13 +327,43 @@ func (s *SocialEngine) handleForumCreation(context actor.Context, msg *proto.Cre
         return
     }
 
-    s.forums[msg.Name] = &ForumData{
-        Name:     msg.Name,
-        Members:  make(map[string]bool),
-        Contents: make([]*proto.Content, 0),
-        Created:  time.Now(),
+    owner, exists := s.users.get(msg.UserHandle)
+    if !exists {
+        context.Respond(&proto.CreateForumResponse{
+            Success: false,
+            Message: "User not found",
+        })
+        return
     }
 
+    if problem := validateForumSettings(msg.Settings); problem != "" {
+        context.Respond(&proto.CreateForumResponse{
+            Success: false,
+            Message: problem,
+        })
+        return
+    }
+
+    // The creator owns the forum and is its first member
+    forum := &ForumData{
+        Name:       msg.Name,
+        Owner:      msg.UserHandle,
+        Creator:    msg.UserHandle,
+        Moderators: make(map[string]bool),
+        Members:    map[string]bool{msg.UserHandle: true},
+        Banned:     make(map[string]string),
+        Contents:   make([]*proto.Content, 0),
+        Created:    s.now(context),
+
+        Invited:      make(map[string]bool),
+        Approved:     make(map[string]bool),
+        JoinRequests: make(map[string]*proto.JoinRequest),
+    }
+    forum.applySettings(msg.Settings)
+    s.persistForum(forum)
+    s.forums.put(msg.Name, forum)
+    s.setMembership(owner, msg.Name, true)
+
     log.Printf("New forum created: %s", msg.Name)
     context.Respond(&proto.CreateForumResponse{
         Success: true,
@@ -148,11 +372,8 @@ func (s *SocialEngine) handleForumCreation(context actor.Context, msg *proto.Cre
 }
 
 func (s *SocialEngine) handleForumJoin(context actor.Context, msg *proto.JoinForum) {
-    s.mutex.Lock()
-    defer s.mutex.Unlock()
-
-    user, userExists := s.users[msg.UserHandle]
-    forum, forumExists := s.forums[msg.Subreddit]
+    user, userExists := s.users.get(msg.UserHandle)
+    forum, forumExists := s.forums.get(msg.Subreddit)
 
     if !userExists {
         context.Respond(&proto.JoinForumResponse{
@@ -170,6 +391,17 @@ func (s *SocialEngine) handleForumJoin(context actor.Context, msg *proto.JoinFor
         return
     }
 
+    forum.mutex.Lock()
+    defer forum.mutex.Unlock()
+
+    if _, banned := forum.Banned[msg.UserHandle]; banned {
+        context.Respond(&proto.JoinForumResponse{
+            Success: false,
+            Message: "User is banned from this forum",
+        })
+        return
+    }
+
     if forum.Members[msg.UserHandle] {
         context.Respond(&proto.JoinForumResponse{
             Success: false,
@@ -178,8 +410,21 @@ func (s *SocialEngine) handleForumJoin(context actor.Context, msg *proto.JoinFor
         return
     }
 
+    // Private forums only admit approved users; joining accepts an invitation
+    if forum.Visibility == VisibilityPrivate && !forum.Approved[msg.UserHandle] && !forum.Invited[msg.UserHandle] {
+        context.Respond(&proto.JoinForumResponse{
+            Success: false,
+            Message: "This forum is private; request to join or ask for an invitation",
+        })
+        return
+    }
+
+    if forum.Invited[msg.UserHandle] {
+        forum.approve(msg.UserHandle)
+    }
     forum.Members[msg.UserHandle] = true
-    user.Forums[msg.Subreddit] = true
+    s.setMembership(user, msg.Subreddit, true)
+    s.persistForum(forum)
 
     log.Printf("User %s joined forum %s", msg.UserHandle, msg.Subreddit)
     context.Respond(&proto.JoinForumResponse{
@@ -189,11 +434,8 @@ func (s *SocialEngine) handleForumJoin(context actor.Context, msg *proto.JoinFor
 }
 
 func (s *SocialEngine) handleForumLeave(context actor.Context, msg *proto.LeaveForum) {
-    s.mutex.Lock()
-    defer s.mutex.Unlock()
-
-    user, userExists := s.users[msg.UserHandle]
-    forum, forumExists := s.forums[msg.Subreddit]
+    user, userExists := s.users.get(msg.UserHandle)
+    forum, forumExists := s.forums.get(msg.Subreddit)
 
     if !userExists || !forumExists {
         context.Respond(&proto.LeaveForumResponse{
@@ -203,6 +445,9 @@ func (s *SocialEngine) handleForumLeave(context actor.Context, msg *proto.LeaveF
         return
     }
 
+    forum.mutex.Lock()
+    defer forum.mutex.Unlock()
+
     if !forum.Members[msg.UserHandle] {
         context.Respond(&proto.LeaveForumResponse{
             Success: false,
@@ -212,7 +457,8 @@ func (s *SocialEngine) handleForumLeave(context actor.Context, msg *proto.LeaveF
     }
 
     delete(forum.Members, msg.UserHandle)
-    delete(user.Forums, msg.Subreddit)
+    s.setMembership(user, msg.Subreddit, false)
+    s.persistForum(forum)
 
     log.Printf("User %s left forum %s", msg.UserHandle, msg.Subreddit)
     context.Respond(&proto.LeaveForumResponse{
@@ -221,11 +467,22 @@ func (s *SocialEngine) handleForumLeave(context actor.Context, msg *proto.LeaveF
     })
 }
 
-func (s *SocialEngine) handleGetForumDetails(context actor.Context, msg *proto.GetForumDetails) {
-    s.mutex.RLock()
-    defer s.mutex.RUnlock()
+// setMembership adds a forum to a user's list or drops it, and stores the
+// user. Callers hold the forum's lock; the user is locked here.
+func (s *SocialEngine) setMembership(user *UserData, forumName string, member bool) {
+    user.mutex.Lock()
+    defer user.mutex.Unlock()
 
-    forum, exists := s.forums[msg.ForumName]
+    if member {
+        user.Forums[forumName] = true
+    } else {
+        delete(user.Forums, forumName)
+    }
+    s.persistUser(user)
+}
+
+func (s *SocialEngine) handleGetForumDetails(context actor.Context, msg *proto.GetForumDetails) {
+    forum, exists := s.forums.get(msg.ForumName)
     if !exists {
         context.Respond(&proto.ForumDetails{
             Success: false,
@@ -234,22 +491,72 @@ func (s *SocialEngine) handleGetForumDetails(context actor.Context, msg *proto.G
         return
     }
 
+    forum.mutex.RLock()
+    defer forum.mutex.RUnlock()
+
+    // Anyone may see that a private forum exists, but not what is in it
+    if !forum.canView(msg.Viewer) {
+        context.Respond(&proto.ForumDetails{
+            Success:        true,
+            Name:           forum.Name,
+            MemberCount:    int32(len(forum.Members)),
+            Message:        "This forum is private",
+            Owner:          forum.Owner,
+            Moderators:     forum.moderatorList(),
+            Creator:        forum.Creator,
+            Created:        forum.Created.Unix(),
+            Settings:       forum.settings(),
+            ContentsHidden: true,
+        })
+        return
+    }
+
+    posts, err := s.ranking(msg.SortMethod, msg.Period, rankingTime(msg.After, msg.Before))
+    if err != nil {
+        context.Respond(&proto.ForumDetails{
+            Success: false,
+            Message: rankingProblem(err),
+        })
+        return
+    }
+
+    contents := make([]*proto.Content, 0, len(forum.Contents))
+    for _, content := range forum.Contents {
+        if posts.lists(content) {
+            contents = append(contents, content)
+        }
+    }
+
+    result, err := posts.paginate(posts.sorted(contents), msg.After, msg.Before, msg.Limit)
+    if err != nil {
+        context.Respond(&proto.ForumDetails{
+            Success: false,
+            Message: "Invalid cursor",
+        })
+        return
+    }
+
     response := &proto.ForumDetails{
         Success:      true,
         Name:         forum.Name,
         MemberCount:  int32(len(forum.Members)),
-        Contents:     forum.Contents,
+        Contents:     shownContents(result.items),
         Message:      "Forum details retrieved successfully",
+        Owner:        forum.Owner,
+        Moderators:   forum.moderatorList(),
+        NextCursor:   result.next,
+        PrevCursor:   result.prev,
+        Creator:      forum.Creator,
+        Created:      forum.Created.Unix(),
+        Settings:     forum.settings(),
     }
 
     context.Respond(response)
 }
 
 func (s *SocialEngine) handleContentCreation(context actor.Context, msg *proto.CreateContent) {
-    s.mutex.Lock()
-    defer s.mutex.Unlock()
-
-    if _, exists := s.users[msg.UserHandle]; !exists {
+    user, exists := s.users.get(msg.UserHandle)
+    if !exists {
         context.Respond(&proto.CreateContentResponse{
             Success: false,
             Message: "User not found",
@@ -257,7 +564,7 @@ func (s *SocialEngine) handleContentCreation(context actor.Context, msg *proto.C
         return
     }
 
-    forum, exists := s.forums[msg.Subreddit]
+    forum, exists := s.forums.get(msg.Subreddit)
     if !exists {
         context.Respond(&proto.CreateContentResponse{
             Success: false,
@@ -266,23 +573,65 @@ func (s *SocialEngine) handleContentCreation(context actor.Context, msg *proto.C
         return
     }
 
-    contentId := utils.GenerateID("cnt")
+    forum.mutex.Lock()
+    defer forum.mutex.Unlock()
+
+    if _, banned := forum.Banned[msg.UserHandle]; banned {
+        context.Respond(&proto.CreateContentResponse{
+            Success: false,
+            Message: "User is banned from this forum",
+        })
+        return
+    }
+
+    contentId := s.newID(context, "cnt")
     content := &proto.Content{
         ContentId:         contentId,
         Creator:          msg.UserHandle,
         Subreddit:        msg.Subreddit,
         Heading:          msg.Heading,
         Body:             msg.Body,
-        Timestamp:        time.Now().Unix(),
+        Timestamp:        s.now(context).Unix(),
         Feedback:         make([]*proto.Feedback, 0),
         Reactions:        make(map[string]int32),
         Points:           0,
         IsShare:          msg.IsShare,
         OriginalContentId: msg.OriginalContentId,
     }
+    if problem := typePost(content, msg); problem != "" {
+        context.Respond(&proto.CreateContentResponse{
+            Success: false,
+            Message: problem,
+        })
+        return
+    }
+    if problem := forum.postingProblem(user, content.Kind); problem != "" {
+        context.Respond(&proto.CreateContentResponse{
+            Success: false,
+            Message: problem,
+        })
+        return
+    }
+    if problem := s.linkCrosspost(forum, content); problem != "" {
+        context.Respond(&proto.CreateContentResponse{
+            Success: false,
+            Message: problem,
+        })
+        return
+    }
 
-    s.contents[contentId] = content
+    s.contents.put(contentId, content)
     forum.Contents = append(forum.Contents, content)
+    s.persistContent(content)
+    s.persistForum(forum)
+    if content.IsShare {
+        s.addCrosspost(forum, content)
+    }
+    if content.Media != nil {
+        s.media.add(content.Media.Hash, content)
+    }
+    s.publishContent(context, content)
+    s.notifyMentions(context, content, nil, content.Heading+"\n"+content.Body, "")
 
     log.Printf("New content created in %s by %s", msg.Subreddit, msg.UserHandle)
     context.Respond(&proto.CreateContentResponse{
@@ -293,10 +642,7 @@ func (s *SocialEngine) handleContentCreation(context actor.Context, msg *proto.C
 }
 
 func (s *SocialEngine) handleFeedbackCreation(context actor.Context, msg *proto.CreateFeedback) {
-    s.mutex.Lock()
-    defer s.mutex.Unlock()
-
-    if _, exists := s.users[msg.UserHandle]; !exists {
+    if _, exists := s.users.get(msg.UserHandle); !exists {
         context.Respond(&proto.CreateFeedbackResponse{
             Success: false,
             Message: "User not found",
@@ -304,7 +650,15 @@ func (s *SocialEngine) handleFeedbackCreation(context actor.Context, msg *proto.
         return
     }
 
-    content, exists := s.contents[msg.ContentId]
+    // Replies may be addressed by their parent alone
+    contentId := msg.ContentId
+    if contentId == "" && msg.ParentId != "" {
+        if parent, exists := s.feedbacks.get(msg.ParentId); exists {
+            contentId = parent.ContentId
+        }
+    }
+
+    content, forum, exists := s.lookupContent(contentId)
     if !exists {
         context.Respond(&proto.CreateFeedbackResponse{
             Success: false,
@@ -313,25 +667,56 @@ func (s *SocialEngine) handleFeedbackCreation(context actor.Context, msg *proto.
         return
     }
 
-    feedbackId := utils.GenerateID("fdb")
+    forum.mutex.Lock()
+    defer forum.mutex.Unlock()
+
+    if content.IsLocked || !isVisible(content) {
+        context.Respond(&proto.CreateFeedbackResponse{
+            Success: false,
+            Message: "Content is locked",
+        })
+        return
+    }
+
+    if _, banned := forum.Banned[msg.UserHandle]; banned {
+        context.Respond(&proto.CreateFeedbackResponse{
+            Success: false,
+            Message: "User is banned from this forum",
+        })
+        return
+    }
+    if !forum.canView(msg.UserHandle) {
+        context.Respond(&proto.CreateFeedbackResponse{
+            Success: false,
+            Message: "Content not found",
+        })
+        return
+    }
+
+    feedbackId := s.newID(context, "fdb")
     feedback := &proto.Feedback{
         FeedbackId:  feedbackId,
-        ContentId:   msg.ContentId,
+        ContentId:   contentId,
         Creator:     msg.UserHandle,
         Body:        msg.Body,
-        Timestamp:   time.Now().Unix(),
+        Timestamp:   s.now(context).Unix(),
         ParentId:    msg.ParentId,
         Replies:     make([]*proto.Feedback, 0),
         Reactions:   make(map[string]int32),
         Points:      0,
     }
 
-    s.feedbacks[feedbackId] = feedback
-
     if msg.ParentId == "" {
         content.Feedback = append(content.Feedback, feedback)
     } else {
-        if parent, exists := s.feedbacks[msg.ParentId]; exists {
+        if parent, exists := s.feedbacks.get(msg.ParentId); exists && parent.ContentId == contentId {
+            if parent.IsDeleted || parent.IsRemoved {
+                context.Respond(&proto.CreateFeedbackResponse{
+                    Success: false,
+                    Message: "Parent feedback is no longer available",
+                })
+                return
+            }
             parent.Replies = append(parent.Replies, feedback)
         } else {
             context.Respond(&proto.CreateFeedbackResponse{
@@ -342,6 +727,11 @@ func (s *SocialEngine) handleFeedbackCreation(context actor.Context, msg *proto.
         }
     }
 
+    s.feedbacks.put(feedbackId, feedback)
+    s.persistFeedback(feedback)
+    s.publishFeedback(context, feedback)
+    s.notifyReply(context, content, feedback)
+
     context.Respond(&proto.CreateFeedbackResponse{
         Success:    true,
         Message:    "Feedback created successfully",
@@ -350,10 +740,8 @@ func (s *SocialEngine) handleFeedbackCreation(context actor.Context, msg *proto.
 }
 
 func (s *SocialEngine) handleReaction(context actor.Context, msg *proto.Reaction) {
-    s.mutex.Lock()
-    defer s.mutex.Unlock()
-
-    if _, exists := s.users[msg.UserHandle]; !exists {
+    voter, exists := s.users.get(msg.UserHandle)
+    if !exists {
         context.Respond(&proto.ReactionResponse{
             Success: false,
             Message: "User not found",
@@ -361,30 +749,57 @@ func (s *SocialEngine) handleReaction(context actor.Context, msg *proto.Reaction
         return
     }
 
+    contentId := msg.ItemId
+    if !msg.IsContent {
+        contentId = ""
+        if feedback, exists := s.feedbacks.get(msg.ItemId); exists {
+            contentId = feedback.ContentId
+        }
+    }
+
+    content, forum, exists := s.lookupContent(contentId)
+    if !exists {
+        context.Respond(&proto.ReactionResponse{
+            Success: false,
+            Message: "Item not found",
+        })
+        return
+    }
+
+    forum.mutex.Lock()
+    defer forum.mutex.Unlock()
+
     value := int32(1)
     if !msg.IsPositive {
         value = -1
     }
 
-    var success bool
+    var reactions map[string]int32
+    var points *int32
+    var persist func()
+    var author string
 
     if msg.IsContent {
-        if content, exists := s.contents[msg.ItemId]; exists {
-            previousValue := content.Reactions[msg.UserHandle]
-            content.Reactions[msg.UserHandle] = value
-            content.Points += value - previousValue
-            success = true
+        if isVisible(content) {
+            if content.Reactions == nil {
+                content.Reactions = make(map[string]int32)
+            }
+            reactions, points = content.Reactions, &content.Points
+            persist = func() { s.persistContent(content) }
+            author = content.Creator
         }
     } else {
-        if feedback, exists := s.feedbacks[msg.ItemId]; exists {
-            previousValue := feedback.Reactions[msg.UserHandle]
-            feedback.Reactions[msg.UserHandle] = value
-            feedback.Points += value - previousValue
-            success = true
+        if feedback, _ := s.feedbacks.get(msg.ItemId); !feedback.IsDeleted && !feedback.IsRemoved {
+            if feedback.Reactions == nil {
+                feedback.Reactions = make(map[string]int32)
+            }
+            reactions, points = feedback.Reactions, &feedback.Points
+            persist = func() { s.persistFeedback(feedback) }
+            author = feedback.Creator
         }
     }
 
-    if !success {
+    if reactions == nil || !s.canViewContent(content, msg.UserHandle) {
         context.Respond(&proto.ReactionResponse{
             Success: false,
             Message: "Item not found",
@@ -392,6 +807,39 @@ func (s *SocialEngine) handleReaction(context actor.Context, msg *proto.Reaction
         return
     }
 
+    // Votes from quarantined accounts are kept but count for nothing
+    weight := s.voteWeight(msg.UserHandle)
+    previousValue, voted := reactions[msg.UserHandle]
+    if msg.Retract {
+        if !voted {
+            context.Respond(&proto.ReactionResponse{
+                Success: false,
+                Message: "No reaction to remove",
+            })
+            return
+        }
+        delete(reactions, msg.UserHandle)
+        *points -= weight * previousValue
+        persist()
+        s.adjustKarma(author, msg.IsContent, -weight*previousValue)
+        s.publishVote(context, msg.ItemId, contentId, msg.IsContent, *points)
+
+        context.Respond(&proto.ReactionResponse{
+            Success: true,
+            Message: "Reaction removed successfully",
+        })
+        return
+    }
+
+    reactions[msg.UserHandle] = value
+    *points += weight * (value - previousValue)
+    persist()
+    s.adjustKarma(author, msg.IsContent, weight*(value-previousValue))
+    s.publishVote(context, msg.ItemId, contentId, msg.IsContent, *points)
+
+    found := s.suspects(voter, author, msg.ItemId, msg.IsPositive, s.now(context))
+    s.reportSuspects(context, found)
+
     context.Respond(&proto.ReactionResponse{
         Success: true,
         Message: "Reaction recorded successfully",
@@ -399,10 +847,7 @@ func (s *SocialEngine) handleReaction(context actor.Context, msg *proto.Reaction
 }
 
 func (s *SocialEngine) handleGetPost(context actor.Context, msg *proto.GetPost) {
-    s.mutex.RLock()
-    defer s.mutex.RUnlock()
-
-    content, exists := s.contents[msg.ContentId]
+    content, forum, exists := s.lookupContent(msg.ContentId)
     if !exists {
         context.Respond(&proto.GetPostResponse{
             Success: false,
@@ -412,18 +857,70 @@ func (s *SocialEngine) handleGetPost(context actor.Context, msg *proto.GetPost)
         return
     }
 
+    forum.mutex.RLock()
+    defer forum.mutex.RUnlock()
+
+    if !s.canViewContent(content, msg.Viewer) {
+        context.Respond(&proto.GetPostResponse{
+            Success: false,
+            Message: "Post not found",
+            Content: nil,
+        })
+        return
+    }
+
     context.Respond(&proto.GetPostResponse{
-        Success: true,
-        Message: "Post retrieved successfully",
-        Content: content,
+        Success:      true,
+        Message:      "Post retrieved successfully",
+        Content:      detachedContent(content),
+        CommentCount: threadSize(content.Feedback),
     })
 }
 
-func (s *SocialEngine) handleFeedRequest(context actor.Context, msg *proto.GetFeed) {
-    s.mutex.RLock()
-    defer s.mutex.RUnlock()
+func (s *SocialEngine) handleGetFeedback(context actor.Context, msg *proto.GetFeedback) {
+    feedback, content, forum, exists := s.lookupFeedback(msg.FeedbackId)
+    if exists {
+        forum.mutex.RLock()
+        defer forum.mutex.RUnlock()
+
+        exists = s.canViewContent(content, msg.Viewer)
+    }
+    if !exists {
+        context.Respond(&proto.GetFeedbackResponse{
+            Success: false,
+            Message: "Feedback not found",
+        })
+        return
+    }
+
+    response := &proto.GetFeedbackResponse{
+        Success:  true,
+        Message:  "Feedback retrieved successfully",
+        Feedback: detachedFeedback(feedback),
+    }
+
+    if msg.IncludeReplies {
+        result, err := replyListing.paginate(replyListing.sorted(feedback.Replies), msg.After, msg.Before, msg.Limit)
+        if err != nil {
+            context.Respond(&proto.GetFeedbackResponse{
+                Success: false,
+                Message: "Invalid cursor",
+            })
+            return
+        }
+        response.Feedback.Replies = make([]*proto.Feedback, 0, len(result.items))
+        for _, reply := range result.items {
+            response.Feedback.Replies = append(response.Feedback.Replies, detachedFeedback(reply))
+        }
+        response.NextCursor = result.next
+        response.PrevCursor = result.prev
+    }
+
+    context.Respond(response)
+}
 
-    user, exists := s.users[msg.UserHandle]
+func (s *SocialEngine) handleFeedRequest(context actor.Context, msg *proto.GetFeed) {
+    user, exists := s.users.get(msg.UserHandle)
     if !exists {
         context.Respond(&proto.FeedBundle{
             Success: false,
@@ -433,67 +930,55 @@ func (s *SocialEngine) handleFeedRequest(context actor.Context, msg *proto.GetFe
         return
     }
 
-    var contents []*proto.Content
-    for forumName := range user.Forums {
-        if forum, exists := s.forums[forumName]; exists {
-            contents = append(contents, forum.Contents...)
-        }
+    feed, err := s.ranking(msg.SortMethod, msg.Period, rankingTime(msg.After, msg.Before))
+    if err != nil {
+        context.Respond(&proto.FeedBundle{
+            Success: false,
+            Message: rankingProblem(err),
+        })
+        return
     }
 
-    switch msg.SortMethod {
-    case "hot":
-        sort.Slice(contents, func(i, j int) bool {
-            // Calculate ups and downs from reactions
-            iUps := 0
-            iDowns := 0
-            for _, value := range contents[i].Reactions {
-                if value > 0 {
-                    iUps++
-                } else {
-                    iDowns++
-                }
-            }
-            
-            jUps := 0
-            jDowns := 0
-            for _, value := range contents[j].Reactions {
-                if value > 0 {
-                    jUps++
-                } else {
-                    jDowns++
-                }
+    user.mutex.RLock()
+    forumNames := user.forumList()
+    user.mutex.RUnlock()
+
+    posts := feed.gather()
+    for _, forumName := range forumNames {
+        forum, exists := s.forums.get(forumName)
+        if !exists {
+            continue
+        }
+        forum.mutex.RLock()
+        if forum.canView(user.Handle) {
+            for _, content := range forum.Contents {
+                posts.add(content)
             }
-            
-            scoreI := utils.CalculateHotScore(iUps, iDowns, contents[i].Timestamp)
-            scoreJ := utils.CalculateHotScore(jUps, jDowns, contents[j].Timestamp)
-            return scoreI > scoreJ
-        })
-    case "new":
-        sort.Slice(contents, func(i, j int) bool {
-            return contents[i].Timestamp > contents[j].Timestamp
-        })
-    case "top":
-        sort.Slice(contents, func(i, j int) bool {
-            return contents[i].Points > contents[j].Points
-        })
+        }
+        forum.mutex.RUnlock()
     }
 
-    if msg.Limit > 0 && len(contents) > int(msg.Limit) {
-        contents = contents[:msg.Limit]
+    result, err := posts.paginate(msg.After, msg.Before, msg.Limit)
+    if err != nil {
+        context.Respond(&proto.FeedBundle{
+            Success: false,
+            Message: "Invalid cursor",
+        })
+        return
     }
 
     context.Respond(&proto.FeedBundle{
         Success: true,
         Message: "Feed retrieved successfully",
-        Contents: contents,
+        Contents: s.shownPosts(result.items),
+        NextCursor: result.next,
+        PrevCursor: result.prev,
     })
 }
 
 func (s *SocialEngine) handleChatDelivery(context actor.Context, msg *proto.DirectChat) {
-    s.mutex.Lock()
-    defer s.mutex.Unlock()
-
-    if _, exists := s.users[msg.Sender]; !exists {
+    sender, exists := s.users.get(msg.Sender)
+    if !exists {
         context.Respond(&proto.ChatResponse{
             Success: false,
             Message: "Sender not found",
@@ -501,7 +986,8 @@ func (s *SocialEngine) handleChatDelivery(context actor.Context, msg *proto.Dire
         return
     }
 
-    if _, exists := s.users[msg.Receiver]; !exists {
+    receiver, exists := s.users.get(msg.Receiver)
+    if !exists {
         context.Respond(&proto.ChatResponse{
             Success: false,
             Message: "Receiver not found",
@@ -509,14 +995,20 @@ func (s *SocialEngine) handleChatDelivery(context actor.Context, msg *proto.Dire
         return
     }
 
-    msg.MessageId = utils.GenerateID("msg")
-    msg.Timestamp = time.Now().Unix()
+    // Messages are numbered by their journal entry, which orders them the
+    // same way every time they are applied
+    msg.MessageId = s.newID(context, "msg")
+    msg.Timestamp = s.now(context).Unix()
     msg.Seen = false
+    msg.Sequence = commandSequence(context)
 
-    if _, exists := s.chats[msg.Receiver]; !exists {
-        s.chats[msg.Receiver] = make([]*proto.DirectChat, 0)
-    }
-    s.chats[msg.Receiver] = append(s.chats[msg.Receiver], msg)
+    unlock := lockUsers(sender, receiver)
+    receiver.inbox = append(receiver.inbox, msg)
+    addToConversation(sender, receiver, msg)
+    s.persistChat(msg)
+    s.publishChat(context, msg)
+    unlock()
+    s.notifyMessage(context, msg)
 
     log.Printf("Message delivered from %s to %s", msg.Sender, msg.Receiver)
     context.Respond(&proto.ChatResponse{
@@ -525,11 +1017,11 @@ func (s *SocialEngine) handleChatDelivery(context actor.Context, msg *proto.Dire
     })
 }
 
+// handleChatRetrieval lists a user's inbox. Listing no longer marks messages
+// as read; see handleMarkRead.
 func (s *SocialEngine) handleChatRetrieval(context actor.Context, msg *proto.GetChats) {
-    s.mutex.Lock()
-    defer s.mutex.Unlock()
-
-    if _, exists := s.users[msg.UserHandle]; !exists {
+    user, exists := s.users.get(msg.UserHandle)
+    if !exists {
         context.Respond(&proto.ChatBundle{
             Success: false,
             Message: "User not found",
@@ -538,25 +1030,31 @@ func (s *SocialEngine) handleChatRetrieval(context actor.Context, msg *proto.Get
         return
     }
 
-    messages := s.chats[msg.UserHandle]
-    // Mark all messages as seen when retrieved
-    for _, message := range messages {
-        message.Seen = true
+    user.mutex.RLock()
+    defer user.mutex.RUnlock()
+
+    result, err := chatListing.paginate(chatListing.sorted(user.inbox), msg.After, msg.Before, msg.Limit)
+    if err != nil {
+        context.Respond(&proto.ChatBundle{
+            Success: false,
+            Message: "Invalid cursor",
+        })
+        return
     }
 
+    messages := clonedChats(result.items)
     log.Printf("Retrieved %d messages for user %s", len(messages), msg.UserHandle)
     context.Respond(&proto.ChatBundle{
         Success: true,
         Message: "Messages retrieved successfully",
         Messages: messages,
+        NextCursor: result.next,
+        PrevCursor: result.prev,
     })
 }
 
 func (s *SocialEngine) handleActivityUpdate(context actor.Context, msg *proto.ActivityStatus) {
-    s.mutex.Lock()
-    defer s.mutex.Unlock()
-
-    user, exists := s.users[msg.UserHandle]
+    user, exists := s.users.get(msg.UserHandle)
     if !exists {
         context.Respond(&proto.ActivityStatusResponse{
             Success: false,
@@ -565,8 +1063,11 @@ func (s *SocialEngine) handleActivityUpdate(context actor.Context, msg *proto.Ac
         return
     }
 
+    user.mutex.Lock()
     user.IsOnline = msg.IsOnline
-    user.LastSeen = time.Now()
+    user.LastSeen = s.now(context)
+    s.persistUser(user)
+    user.mutex.Unlock()
 
     log.Printf("Updated activity status for user %s: online=%v", msg.UserHandle, msg.IsOnline)
     context.Respond(&proto.ActivityStatusResponse{
@@ -575,52 +1076,170 @@ func (s *SocialEngine) handleActivityUpdate(context actor.Context, msg *proto.Ac
     })
 }
 
+// handleCheckPassword tells whether a password is the user's. Comparing
+// against the hash is slow on purpose, so it is done after the lock is
+// released.
+func (s *SocialEngine) handleCheckPassword(context actor.Context, msg *proto.CheckPassword) {
+    user, exists := s.users.get(msg.UserHandle)
+    var hash string
+    if exists {
+        user.mutex.RLock()
+        hash = user.PasswordHash
+        user.mutex.RUnlock()
+    }
+
+    if !exists || !auth.CheckPassword(hash, msg.Password) {
+        context.Respond(&proto.PasswordCheck{
+            Success: false,
+            Message: "Invalid username or password",
+        })
+        return
+    }
+
+    context.Respond(&proto.PasswordCheck{
+        Success: true,
+        Message: "Password accepted",
+    })
+}
+
 // Helper methods
 
-func (s *SocialEngine) cleanup() {
-    s.mutex.Lock()
-    defer s.mutex.Unlock()
+// lookupContent finds a post and the forum whose lock guards it
+func (s *SocialEngine) lookupContent(contentId string) (*proto.Content, *ForumData, bool) {
+    content, exists := s.contents.get(contentId)
+    if !exists {
+        return nil, nil, false
+    }
+    forum, exists := s.forums.get(content.Subreddit)
+    return content, forum, exists
+}
+
+// lookupFeedback finds a comment, its post and the forum whose lock guards
+// them
+func (s *SocialEngine) lookupFeedback(feedbackId string) (*proto.Feedback, *proto.Content, *ForumData, bool) {
+    feedback, exists := s.feedbacks.get(feedbackId)
+    if !exists {
+        return nil, nil, nil, false
+    }
+    content, forum, exists := s.lookupContent(feedback.ContentId)
+    return feedback, content, forum, exists
+}
+
+// lockUsers locks two users, or one if both are the same, in handle order
+// so that commands locking the same pair never wait on each other. It
+// returns the function that unlocks them.
+func lockUsers(a, b *UserData) func() {
+    if a == b {
+        a.mutex.Lock()
+        return a.mutex.Unlock
+    }
+    if a.Handle > b.Handle {
+        a, b = b, a
+    }
+    a.mutex.Lock()
+    b.mutex.Lock()
+    return func() {
+        b.mutex.Unlock()
+        a.mutex.Unlock()
+    }
+}
 
+func (s *SocialEngine) cleanup() {
     // Remove old messages (older than 30 days)
     thirtyDaysAgo := time.Now().AddDate(0, 0, -30).Unix()
-    for username, messages := range s.chats {
+    for _, user := range s.users.all() {
+        user.mutex.Lock()
         filtered := make([]*proto.DirectChat, 0)
-        for _, msg := range messages {
+        for _, msg := range user.inbox {
             if msg.Timestamp > thirtyDaysAgo {
                 filtered = append(filtered, msg)
             }
         }
-        s.chats[username] = filtered
+        user.inbox = filtered
+        user.mutex.Unlock()
     }
+    s.rebuildConversations()
 
     // Mark users as offline if they haven't been seen in 5 minutes
-    fiveMinutesAgo := time.Now().Add(-5 * time.Minute)
-    for _, user := range s.users {
+    fiveMinutesAgo := time.Now().Add(-onlineTimeout)
+    for _, user := range s.users.all() {
+        user.mutex.Lock()
         if user.IsOnline && user.LastSeen.Before(fiveMinutesAgo) {
             user.IsOnline = false
         }
+        user.mutex.Unlock()
     }
 }
 
-func (s *SocialEngine) getStats() map[string]interface{} {
-    s.mutex.RLock()
-    defer s.mutex.RUnlock()
+// detachedContent copies a post without its comments, leaving the live post
+// untouched so that readers may call it under the forum's read lock. Fields
+// added to Content need to be copied here too.
+func detachedContent(content *proto.Content) *proto.Content {
+    return protobuf.Clone(&proto.Content{
+        ContentId:         content.ContentId,
+        Creator:           shownCreator(content.Creator, content.IsDeleted),
+        Subreddit:         content.Subreddit,
+        Heading:           content.Heading,
+        Body:              content.Body,
+        Timestamp:         content.Timestamp,
+        Reactions:         content.Reactions,
+        Points:            content.Points,
+        IsShare:           content.IsShare,
+        OriginalContentId: content.OriginalContentId,
+        IsLocked:          content.IsLocked,
+        IsRemoved:         content.IsRemoved,
+        RemovalReason:     content.RemovalReason,
+        Revisions:         content.Revisions,
+        EditedAt:          content.EditedAt,
+        IsDeleted:         content.IsDeleted,
+        Kind:              content.Kind,
+        Url:               content.Url,
+        Domain:            content.Domain,
+        Media:             content.Media,
+        Original:          content.Original,
+        CrosspostCount:    content.CrosspostCount,
+    }).(*proto.Content)
+}
 
+// detachedFeedback copies a comment without its replies, leaving the live
+// comment untouched so that readers may call it under the forum's read lock.
+// Fields added to Feedback need to be copied here too.
+func detachedFeedback(feedback *proto.Feedback) *proto.Feedback {
+    return protobuf.Clone(&proto.Feedback{
+        FeedbackId:    feedback.FeedbackId,
+        ContentId:     feedback.ContentId,
+        Creator:       shownCreator(feedback.Creator, feedback.IsDeleted),
+        Body:          feedback.Body,
+        Timestamp:     feedback.Timestamp,
+        ParentId:      feedback.ParentId,
+        Reactions:     feedback.Reactions,
+        Points:        feedback.Points,
+        IsRemoved:     feedback.IsRemoved,
+        RemovalReason: feedback.RemovalReason,
+        Revisions:     feedback.Revisions,
+        EditedAt:      feedback.EditedAt,
+        IsDeleted:     feedback.IsDeleted,
+    }).(*proto.Feedback)
+}
+
+func (s *SocialEngine) getStats() map[string]interface{} {
     return map[string]interface{}{
-        "total_users":    len(s.users),
-        "total_forums":   len(s.forums),
-        "total_posts":    len(s.contents),
-        "total_comments": len(s.feedbacks),
+        "total_users":    s.users.len(),
+        "total_forums":   s.forums.len(),
+        "total_posts":    s.contents.len(),
+        "total_comments": s.feedbacks.len(),
         "online_users":   s.getOnlineUserCount(),
     }
 }
 
 func (s *SocialEngine) getOnlineUserCount() int {
     count := 0
-    for _, user := range s.users {
+    for _, user := range s.users.all() {
+        user.mutex.RLock()
         if user.IsOnline {
             count++
         }
+        user.mutex.RUnlock()
     }
     return count
-}
\ No newline at end of file
+}
@@ -0,0 +1,465 @@
+// engine/vote_integrity.go
+package engine
+
+import (
+    "log"
+    "sort"
+    "strings"
+    "sync"
+    "time"
+    "github.com/asynkron/protoactor-go/actor"
+    "reddit/proto"
+)
+
+// Reasons an account's votes are quarantined
+const (
+    FlagVoteRing      = "vote ring"
+    FlagNewcomerBurst = "new account burst"
+    FlagTargeting     = "targeted voting"
+)
+
+// Thresholds for suspicious voting. Two accounts that each upvote the other
+// ringVotes times within ringWindow form a ring; ageing accounts younger
+// than newcomerAge casting burstVotes votes on one item within burstWindow
+// form a burst; and upvoting one author targetVotes times within
+// targetWindow is targeting.
+const (
+    ringVotes    = 5
+    ringWindow   = 7 * 24 * time.Hour
+    newcomerAge  = time.Hour
+    burstVotes   = 5
+    burstWindow  = 10 * time.Minute
+    targetVotes  = 10
+    targetWindow = 24 * time.Hour
+    ledgerSweep  = 1000
+)
+
+// votePair is a voter and the author of what they voted on
+type votePair struct {
+    voter  string
+    author string
+}
+
+type timedVote struct {
+    voter string
+    at    time.Time
+}
+
+// voteLedger remembers recent votes for as long as they can count towards
+// a pattern. It is stored with the rest of the state, so a restored engine
+// or a replica flags the same voters the engine would have. Votes in every
+// forum go through it, so it has a lock of its own.
+type voteLedger struct {
+    mutex    sync.Mutex
+    upvotes  map[votePair][]time.Time
+    newcomer map[string][]timedVote
+    recorded int
+}
+
+func newVoteLedger() *voteLedger {
+    return &voteLedger{
+        upvotes:  make(map[votePair][]time.Time),
+        newcomer: make(map[string][]timedVote),
+    }
+}
+
+// reset forgets every vote, before the ledger is restored
+func (l *voteLedger) reset() {
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    l.upvotes = make(map[votePair][]time.Time)
+    l.newcomer = make(map[string][]timedVote)
+    l.recorded = 0
+}
+
+// recent drops the times older than window
+func recent(times []time.Time, window time.Duration, now time.Time) []time.Time {
+    cutoff := now.Add(-window)
+    kept := times[:0]
+    for _, at := range times {
+        if at.After(cutoff) {
+            kept = append(kept, at)
+        }
+    }
+    return kept
+}
+
+// addUpvote remembers an upvote from pair's voter on their author
+func (l *voteLedger) addUpvote(pair votePair, at time.Time) {
+    l.upvotes[pair] = append(recent(l.upvotes[pair], max(ringWindow, targetWindow), at), at)
+}
+
+// addNewcomer remembers a new account's vote on an item and returns the
+// new accounts' votes on it within burstWindow, one per voter
+func (l *voteLedger) addNewcomer(itemId string, vote timedVote) []timedVote {
+    var votes []timedVote
+    for _, earlier := range l.newcomer[itemId] {
+        if earlier.at.After(vote.at.Add(-burstWindow)) && earlier.voter != vote.voter {
+            votes = append(votes, earlier)
+        }
+    }
+    votes = append(votes, vote)
+    l.newcomer[itemId] = votes
+    return votes
+}
+
+// apply remembers a stored vote again
+func (l *voteLedger) apply(vote *proto.LedgerVote) {
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    if vote.Forget {
+        l.drop(vote.Voter)
+        return
+    }
+    at := time.Unix(0, vote.At)
+    if vote.ItemId != "" {
+        l.addNewcomer(vote.ItemId, timedVote{voter: vote.Voter, at: at})
+    }
+    if vote.Author != "" {
+        l.addUpvote(votePair{voter: vote.Voter, author: vote.Author}, at)
+    }
+}
+
+// votes lists the remembered votes, oldest first for each author and item,
+// so that applying them in order remembers the same votes
+func (l *voteLedger) votes() []*proto.LedgerVote {
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    var votes []*proto.LedgerVote
+    for pair, times := range l.upvotes {
+        for _, at := range times {
+            votes = append(votes, &proto.LedgerVote{Voter: pair.voter, Author: pair.author, At: at.UnixNano()})
+        }
+    }
+    for itemId, newcomers := range l.newcomer {
+        for _, vote := range newcomers {
+            votes = append(votes, &proto.LedgerVote{Voter: vote.voter, ItemId: itemId, At: vote.at.UnixNano()})
+        }
+    }
+    return votes
+}
+
+// upvotesWithin counts the voter's upvotes on the author's items within
+// window
+func (l *voteLedger) upvotesWithin(pair votePair, window time.Duration, now time.Time) int {
+    count := 0
+    cutoff := now.Add(-window)
+    for _, at := range l.upvotes[pair] {
+        if at.After(cutoff) {
+            count++
+        }
+    }
+    return count
+}
+
+// sweep forgets votes too old to matter to any pattern
+func (l *voteLedger) sweep(now time.Time) {
+    for pair, times := range l.upvotes {
+        if times = recent(times, max(ringWindow, targetWindow), now); len(times) == 0 {
+            delete(l.upvotes, pair)
+        } else {
+            l.upvotes[pair] = times
+        }
+    }
+    for itemId, votes := range l.newcomer {
+        if votes[len(votes)-1].at.Before(now.Add(-burstWindow)) {
+            delete(l.newcomer, itemId)
+        }
+    }
+}
+
+// suspects records a vote and returns the accounts it shows to be voting
+// dishonestly, with the reason for each
+func (s *SocialEngine) suspects(voter *UserData, author string, itemId string, positive bool, now time.Time) map[string]string {
+    ledger := s.ledger
+    ledger.mutex.Lock()
+    defer ledger.mutex.Unlock()
+
+    ledger.recorded++
+    if ledger.recorded%ledgerSweep == 0 {
+        ledger.sweep(now)
+    }
+
+    found := make(map[string]string)
+    record := &proto.LedgerVote{Voter: voter.Handle, At: now.UnixNano()}
+    if now.Sub(voter.Created) < newcomerAge {
+        record.ItemId = itemId
+        votes := ledger.addNewcomer(itemId, timedVote{voter: voter.Handle, at: now})
+        if len(votes) >= burstVotes {
+            for _, vote := range votes {
+                found[vote.voter] = FlagNewcomerBurst
+            }
+        }
+    }
+
+    if !positive || author == voter.Handle {
+        if record.ItemId != "" {
+            s.persistLedgerVote(record)
+        }
+        return found
+    }
+    record.Author = author
+    s.persistLedgerVote(record)
+    pair := votePair{voter: voter.Handle, author: author}
+    ledger.addUpvote(pair, now)
+
+    if ledger.upvotesWithin(pair, ringWindow, now) >= ringVotes &&
+        ledger.upvotesWithin(votePair{voter: author, author: voter.Handle}, ringWindow, now) >= ringVotes {
+        found[voter.Handle] = FlagVoteRing
+        found[author] = FlagVoteRing
+    }
+    if _, flagged := found[voter.Handle]; !flagged && ledger.upvotesWithin(pair, targetWindow, now) >= targetVotes {
+        found[voter.Handle] = FlagTargeting
+    }
+    return found
+}
+
+// isQuarantined reports whether a user's votes are left out of scores
+func (s *SocialEngine) isQuarantined(handle string) bool {
+    _, quarantined := s.quarantined.get(handle)
+    return quarantined
+}
+
+// voteWeight is how much a user's vote counts towards points and karma
+func (s *SocialEngine) voteWeight(handle string) int32 {
+    if s.isQuarantined(handle) {
+        return 0
+    }
+    return 1
+}
+
+// reportSuspects asks the engine to flag the accounts a vote showed to be
+// voting dishonestly. The flag is its own command, so that it is journaled
+// and applied in order with every other one; a replayed vote reports
+// nothing, since the journal already holds the flags it led to.
+func (s *SocialEngine) reportSuspects(context actor.Context, found map[string]string) {
+    if replayed(context) {
+        return
+    }
+    handles := make([]string, 0, len(found))
+    for handle := range found {
+        handles = append(handles, handle)
+    }
+    sort.Strings(handles)
+    for _, handle := range handles {
+        if !s.isQuarantined(handle) {
+            context.Send(s.self, &proto.FlagVoter{UserHandle: handle, Reason: found[handle]})
+        }
+    }
+}
+
+func (s *SocialEngine) handleFlagVoter(context actor.Context, msg *proto.FlagVoter) {
+    s.flagVoter(context, msg.UserHandle, msg.Reason, s.now(context))
+}
+
+// flagVoter quarantines a user's votes, taking the ones already cast back
+// out of points and karma. Flagging a user twice keeps the first reason.
+func (s *SocialEngine) flagVoter(context actor.Context, handle string, reason string, now time.Time) {
+    user, exists := s.users.get(handle)
+    if !exists {
+        return
+    }
+
+    user.mutex.Lock()
+    if user.VoteFlag != "" {
+        user.mutex.Unlock()
+        return
+    }
+    user.VoteFlag = reason
+    user.FlaggedAt = now
+    s.persistUser(user)
+    s.quarantined.put(handle, reason)
+    user.mutex.Unlock()
+
+    s.shiftVotes(context, handle, -1)
+    log.Printf("Quarantined votes of %s: %s", handle, reason)
+}
+
+// shiftVotes adds every vote a user has cast, times sign, to the points of
+// what they voted on and the karma of its author. Votes on removed posts
+// and comments still count towards their author's karma, so they are
+// shifted as well. Each post and comment is changed under its forum's lock.
+func (s *SocialEngine) shiftVotes(context actor.Context, handle string, sign int32) {
+    for _, content := range s.contents.all() {
+        forum, exists := s.forums.get(content.Subreddit)
+        if !exists {
+            continue
+        }
+        forum.mutex.Lock()
+        if value, voted := content.Reactions[handle]; voted {
+            content.Points += sign * value
+            s.persistContent(content)
+            s.adjustKarma(content.Creator, true, sign*value)
+            s.publishVote(context, content.ContentId, content.ContentId, true, content.Points)
+        }
+        forum.mutex.Unlock()
+    }
+    for _, feedback := range s.feedbacks.all() {
+        _, _, forum, exists := s.lookupFeedback(feedback.FeedbackId)
+        if !exists {
+            continue
+        }
+        forum.mutex.Lock()
+        if value, voted := feedback.Reactions[handle]; voted {
+            feedback.Points += sign * value
+            s.persistFeedback(feedback)
+            s.adjustKarma(feedback.Creator, false, sign*value)
+            s.publishVote(context, feedback.FeedbackId, feedback.ContentId, false, feedback.Points)
+        }
+        forum.mutex.Unlock()
+    }
+}
+
+// forget drops a user's votes from the ledger, so that votes they cast
+// before being cleared cannot flag them again
+func (l *voteLedger) forget(handle string) {
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    l.drop(handle)
+}
+
+func (l *voteLedger) drop(handle string) {
+    for pair := range l.upvotes {
+        if pair.voter == handle {
+            delete(l.upvotes, pair)
+        }
+    }
+    for itemId, votes := range l.newcomer {
+        kept := votes[:0]
+        for _, vote := range votes {
+            if vote.voter != handle {
+                kept = append(kept, vote)
+            }
+        }
+        if len(kept) == 0 {
+            delete(l.newcomer, itemId)
+        } else {
+            l.newcomer[itemId] = kept
+        }
+    }
+}
+
+// SetAdmins names the site admins, who may see reports about the whole
+// site and clear flagged voters. It must be called before the engine
+// restores or replays its state.
+func (s *SocialEngine) SetAdmins(handles []string) {
+    s.admins = make(map[string]bool, len(handles))
+    for _, handle := range handles {
+        if handle = strings.TrimSpace(handle); handle != "" {
+            s.admins[handle] = true
+        }
+    }
+}
+
+// handleClearVoteFlag lifts the quarantine on a user's votes when an admin
+// finds them honest, counting their votes again
+func (s *SocialEngine) handleClearVoteFlag(context actor.Context, msg *proto.ClearVoteFlag) {
+    if !s.admins[msg.UserHandle] {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "Only site admins can clear flagged voters",
+        })
+        return
+    }
+
+    user, exists := s.users.get(msg.Target)
+    if !exists || !s.isQuarantined(msg.Target) {
+        context.Respond(&proto.ModerationResponse{
+            Success: false,
+            Message: "User is not flagged",
+        })
+        return
+    }
+
+    user.mutex.Lock()
+    user.VoteFlag = ""
+    user.FlaggedAt = time.Time{}
+    s.persistUser(user)
+    s.quarantined.remove(user.Handle)
+    user.mutex.Unlock()
+
+    s.shiftVotes(context, user.Handle, 1)
+    s.ledger.forget(user.Handle)
+    s.persistLedgerVote(&proto.LedgerVote{Voter: user.Handle, Forget: true})
+
+    log.Printf("Admin %s cleared the vote flag of %s", msg.UserHandle, msg.Target)
+    context.Respond(&proto.ModerationResponse{
+        Success: true,
+        Message: "Votes restored successfully",
+    })
+}
+
+func (s *SocialEngine) handleGetFlaggedVoters(context actor.Context, msg *proto.GetFlaggedVoters) {
+    if !s.admins[msg.UserHandle] {
+        context.Respond(&proto.FlaggedVoterList{
+            Success: false,
+            Message: "Only site admins can see flagged voters",
+        })
+        return
+    }
+
+    voters := make([]*proto.FlaggedVoter, 0)
+    quarantined := make(map[string]int32)
+    for handle := range s.quarantined.all() {
+        user, exists := s.users.get(handle)
+        if !exists {
+            continue
+        }
+        user.mutex.RLock()
+        if user.VoteFlag != "" {
+            voters = append(voters, &proto.FlaggedVoter{
+                UserHandle: user.Handle,
+                Reason:     user.VoteFlag,
+                FlaggedAt:  user.FlaggedAt.Unix(),
+                Created:    user.Created.Unix(),
+            })
+            quarantined[user.Handle] = 0
+        }
+        user.mutex.RUnlock()
+    }
+
+    // Votes are counted one forum at a time
+    for _, forum := range s.forums.all() {
+        forum.mutex.RLock()
+        for _, content := range forum.Contents {
+            countVoters(quarantined, content.Reactions)
+            countThreadVoters(quarantined, content.Feedback)
+        }
+        forum.mutex.RUnlock()
+    }
+    for _, voter := range voters {
+        voter.QuarantinedVotes = quarantined[voter.UserHandle]
+    }
+    sort.Slice(voters, func(i, j int) bool {
+        if voters[i].FlaggedAt != voters[j].FlaggedAt {
+            return voters[i].FlaggedAt > voters[j].FlaggedAt
+        }
+        return voters[i].UserHandle < voters[j].UserHandle
+    })
+
+    context.Respond(&proto.FlaggedVoterList{
+        Success: true,
+        Message: "Flagged voters retrieved successfully",
+        Voters:  voters,
+    })
+}
+
+// countVoters adds one for each of the counted users among reactions
+func countVoters(counts map[string]int32, reactions map[string]int32) {
+    for handle := range reactions {
+        if _, counted := counts[handle]; counted {
+            counts[handle]++
+        }
+    }
+}
+
+func countThreadVoters(counts map[string]int32, feedbacks []*proto.Feedback) {
+    for _, feedback := range feedbacks {
+        countVoters(counts, feedback.Reactions)
+        countThreadVoters(counts, feedback.Replies)
+    }
+}
@@ -0,0 +1,289 @@
+// engine/vote_integrity_test.go
+package engine
+
+import (
+    "fmt"
+    "testing"
+    "time"
+    "reddit/proto"
+)
+
+var ledgerStart = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+// veteran returns a user old enough not to count as a newcomer
+func veteran(handle string) *UserData {
+    return &UserData{Handle: handle, Created: ledgerStart.Add(-30 * 24 * time.Hour)}
+}
+
+func TestSuspectsFindVoteRing(t *testing.T) {
+    s := newTestEngine()
+    alice, bob := veteran("alice"), veteran("bob")
+
+    var found map[string]string
+    for n := 0; n < ringVotes; n++ {
+        at := ledgerStart.Add(time.Duration(n) * time.Hour)
+        if found = s.suspects(alice, "bob", fmt.Sprintf("cnt_b%d", n), true, at); len(found) != 0 {
+            t.Fatalf("one-sided upvotes flagged %v", found)
+        }
+        if n < ringVotes-1 {
+            if found = s.suspects(bob, "alice", fmt.Sprintf("cnt_a%d", n), true, at); len(found) != 0 {
+                t.Fatalf("ring flagged after %d votes each: %v", n+1, found)
+            }
+        }
+    }
+    found = s.suspects(bob, "alice", "cnt_a4", true, ledgerStart.Add(5*time.Hour))
+    if found["alice"] != FlagVoteRing || found["bob"] != FlagVoteRing {
+        t.Errorf("found = %v, want both in a vote ring", found)
+    }
+}
+
+func TestSuspectsIgnoreRingOutsideWindow(t *testing.T) {
+    s := newTestEngine()
+    alice, bob := veteran("alice"), veteran("bob")
+
+    for n := 0; n < ringVotes; n++ {
+        s.suspects(alice, "bob", "cnt_b", true, ledgerStart.Add(time.Duration(n)*time.Hour))
+    }
+    // Bob returns the favour only after alice's votes have aged out
+    later := ledgerStart.Add(ringWindow + 24*time.Hour)
+    for n := 0; n < ringVotes; n++ {
+        if found := s.suspects(bob, "alice", "cnt_a", true, later.Add(time.Duration(n)*time.Hour)); len(found) != 0 {
+            t.Fatalf("votes a week apart flagged %v", found)
+        }
+    }
+}
+
+func TestSuspectsFindNewcomerBurst(t *testing.T) {
+    s := newTestEngine()
+
+    var found map[string]string
+    for n := 0; n < burstVotes; n++ {
+        at := ledgerStart.Add(time.Duration(n) * time.Minute)
+        voter := &UserData{Handle: fmt.Sprintf("fresh%d", n), Created: at.Add(-time.Minute)}
+        found = s.suspects(voter, "author", "cnt_target", true, at)
+        if n < burstVotes-1 && len(found) != 0 {
+            t.Fatalf("flagged after %d newcomer votes: %v", n+1, found)
+        }
+    }
+    if len(found) != burstVotes {
+        t.Fatalf("found = %v, want all %d newcomers", found, burstVotes)
+    }
+    for handle, reason := range found {
+        if reason != FlagNewcomerBurst {
+            t.Errorf("%s flagged for %q, want %q", handle, reason, FlagNewcomerBurst)
+        }
+    }
+
+    // The same votes spread out and from old accounts raise nothing
+    s = newTestEngine()
+    for n := 0; n < burstVotes; n++ {
+        at := ledgerStart.Add(time.Duration(n) * burstWindow)
+        voter := &UserData{Handle: fmt.Sprintf("fresh%d", n), Created: at.Add(-time.Minute)}
+        if found := s.suspects(voter, "author", "cnt_target", true, at); len(found) != 0 {
+            t.Fatalf("spread out newcomer votes flagged %v", found)
+        }
+        if found := s.suspects(veteran(fmt.Sprintf("old%d", n)), "author", "cnt_other", true, ledgerStart); len(found) != 0 {
+            t.Fatalf("old accounts flagged %v", found)
+        }
+    }
+}
+
+func TestSuspectsFindTargeting(t *testing.T) {
+    s := newTestEngine()
+    fan := veteran("fan")
+
+    var found map[string]string
+    for n := 0; n < targetVotes; n++ {
+        found = s.suspects(fan, "idol", fmt.Sprintf("cnt_%d", n), true, ledgerStart.Add(time.Duration(n)*time.Minute))
+        if n < targetVotes-1 && len(found) != 0 {
+            t.Fatalf("flagged after %d votes: %v", n+1, found)
+        }
+    }
+    if len(found) != 1 || found["fan"] != FlagTargeting {
+        t.Errorf("found = %v, want fan targeting", found)
+    }
+}
+
+func TestSuspectsSkipDownvotesAndSelfVotes(t *testing.T) {
+    s := newTestEngine()
+    critic := veteran("critic")
+
+    for n := 0; n < targetVotes*2; n++ {
+        at := ledgerStart.Add(time.Duration(n) * time.Minute)
+        if found := s.suspects(critic, "author", "cnt_down", false, at); len(found) != 0 {
+            t.Fatalf("downvotes flagged %v", found)
+        }
+        if found := s.suspects(critic, "critic", "cnt_own", true, at); len(found) != 0 {
+            t.Fatalf("self votes flagged %v", found)
+        }
+    }
+}
+
+func TestLedgerVotesRoundTrip(t *testing.T) {
+    s := newTestEngine()
+    alice := veteran("alice")
+    fresh := &UserData{Handle: "fresh", Created: ledgerStart}
+    for n := 0; n < 3; n++ {
+        at := ledgerStart.Add(time.Duration(n) * time.Minute)
+        s.suspects(alice, "bob", "cnt_b", true, at)
+        s.suspects(fresh, "bob", "cnt_b", true, at)
+    }
+
+    restored := newVoteLedger()
+    for _, vote := range s.ledger.votes() {
+        restored.apply(vote)
+    }
+    now := ledgerStart.Add(time.Hour)
+    for _, pair := range []votePair{{"alice", "bob"}, {"fresh", "bob"}} {
+        if got, want := restored.upvotesWithin(pair, ringWindow, now), s.ledger.upvotesWithin(pair, ringWindow, now); got != want || got != 3 {
+            t.Errorf("%v has %d upvotes after restore, want %d", pair, got, want)
+        }
+    }
+    if got := len(restored.newcomer["cnt_b"]); got != 1 {
+        t.Errorf("restored %d newcomer votes on cnt_b, want 1", got)
+    }
+
+    restored.apply(&proto.LedgerVote{Voter: "fresh", Forget: true})
+    if restored.upvotesWithin(votePair{"fresh", "bob"}, ringWindow, now) != 0 || len(restored.newcomer) != 0 {
+        t.Error("forgetting a voter left their votes behind")
+    }
+    if restored.upvotesWithin(votePair{"alice", "bob"}, ringWindow, now) != 3 {
+        t.Error("forgetting a voter dropped someone else's votes")
+    }
+}
+
+// votingEngine returns an engine where carol has upvoted a post and a
+// comment of alice's
+func votingEngine(t *testing.T) (*SocialEngine, string, string) {
+    t.Helper()
+    s := newTestEngine()
+    s.SetAdmins([]string{"admin"})
+    for _, handle := range []string{"admin", "alice", "carol"} {
+        run(s, &proto.OnboardUser{UserHandle: handle})
+    }
+    run(s, &proto.CreateForum{Name: "news", UserHandle: "alice"})
+    response, _ := run(s, &proto.CreateContent{UserHandle: "alice", Subreddit: "news", Heading: "Headline", Body: "Story"})
+    contentId := response.(*proto.CreateContentResponse).ContentId
+    response, _ = run(s, &proto.CreateFeedback{UserHandle: "alice", ContentId: contentId, Body: "Update"})
+    feedbackId := response.(*proto.CreateFeedbackResponse).FeedbackId
+
+    for _, vote := range []*proto.Reaction{
+        {UserHandle: "carol", ItemId: contentId, IsContent: true, IsPositive: true},
+        {UserHandle: "carol", ItemId: feedbackId, IsPositive: true},
+    } {
+        if response, _ := run(s, vote); !response.(*proto.ReactionResponse).Success {
+            t.Fatalf("voting: %s", response.(*proto.ReactionResponse).Message)
+        }
+    }
+    return s, contentId, feedbackId
+}
+
+func scores(s *SocialEngine, contentId, feedbackId string) string {
+    content, _ := s.contents.get(contentId)
+    feedback, _ := s.feedbacks.get(feedbackId)
+    alice, _ := s.users.get("alice")
+    return fmt.Sprintf("post %d, comment %d, karma %d/%d", content.Points, feedback.Points, alice.PostKarma, alice.CommentKarma)
+}
+
+func TestFlagVoterQuarantinesVotes(t *testing.T) {
+    s, contentId, feedbackId := votingEngine(t)
+    if got := scores(s, contentId, feedbackId); got != "post 1, comment 1, karma 1/1" {
+        t.Fatalf("before flagging: %s", got)
+    }
+
+    run(s, &proto.FlagVoter{UserHandle: "carol", Reason: FlagTargeting})
+    if got := scores(s, contentId, feedbackId); got != "post 0, comment 0, karma 0/0" {
+        t.Errorf("after flagging: %s", got)
+    }
+    if !s.isQuarantined("carol") || s.voteWeight("carol") != 0 {
+        t.Error("carol is not quarantined")
+    }
+
+    // Flagging again keeps the first reason and takes nothing more away
+    run(s, &proto.FlagVoter{UserHandle: "carol", Reason: FlagVoteRing})
+    carol, _ := s.users.get("carol")
+    if carol.VoteFlag != FlagTargeting {
+        t.Errorf("reflagging changed the reason to %q", carol.VoteFlag)
+    }
+    if got := scores(s, contentId, feedbackId); got != "post 0, comment 0, karma 0/0" {
+        t.Errorf("after flagging twice: %s", got)
+    }
+
+    list := query(s, &proto.GetFlaggedVoters{UserHandle: "admin"}).(*proto.FlaggedVoterList)
+    if len(list.Voters) != 1 || list.Voters[0].UserHandle != "carol" || list.Voters[0].QuarantinedVotes != 2 {
+        t.Errorf("flagged voters = %v, want carol with 2 votes", list.Voters)
+    }
+    if list := query(s, &proto.GetFlaggedVoters{UserHandle: "alice"}).(*proto.FlaggedVoterList); list.Success {
+        t.Error("a non-admin listed flagged voters")
+    }
+}
+
+func TestClearVoteFlagRestoresVotes(t *testing.T) {
+    s, contentId, feedbackId := votingEngine(t)
+    run(s, &proto.FlagVoter{UserHandle: "carol", Reason: FlagTargeting})
+
+    if response, _ := run(s, &proto.ClearVoteFlag{UserHandle: "alice", Target: "carol"}); response.(*proto.ModerationResponse).Success {
+        t.Fatal("a non-admin cleared a flag")
+    }
+    if response, _ := run(s, &proto.ClearVoteFlag{UserHandle: "admin", Target: "carol"}); !response.(*proto.ModerationResponse).Success {
+        t.Fatalf("clearing: %s", response.(*proto.ModerationResponse).Message)
+    }
+    if got := scores(s, contentId, feedbackId); got != "post 1, comment 1, karma 1/1" {
+        t.Errorf("after clearing: %s", got)
+    }
+    if s.isQuarantined("carol") {
+        t.Error("carol is still quarantined")
+    }
+    if response, _ := run(s, &proto.ClearVoteFlag{UserHandle: "admin", Target: "carol"}); response.(*proto.ModerationResponse).Success {
+        t.Error("cleared a flag that was not set")
+    }
+}
+
+func TestFlagVoterChargesAuthorOfDeletedPost(t *testing.T) {
+    s, contentId, feedbackId := votingEngine(t)
+    if response, _ := run(s, &proto.DeleteContent{UserHandle: "alice", ContentId: contentId}); !response.(*proto.EditResponse).Success {
+        t.Fatalf("deleting: %s", response.(*proto.EditResponse).Message)
+    }
+
+    run(s, &proto.FlagVoter{UserHandle: "carol", Reason: FlagTargeting})
+    if got := scores(s, contentId, feedbackId); got != "post 0, comment 0, karma 0/0" {
+        t.Errorf("after flagging: %s", got)
+    }
+}
+
+func TestReactionReportsSuspectsOnce(t *testing.T) {
+    s := newTestEngine()
+    run(s, &proto.OnboardUser{UserHandle: "alice"})
+    run(s, &proto.CreateForum{Name: "news", UserHandle: "alice"})
+    response, _ := run(s, &proto.CreateContent{UserHandle: "alice", Subreddit: "news", Heading: "Headline"})
+    contentId := response.(*proto.CreateContentResponse).ContentId
+
+    // Fresh accounts piling onto one post are reported together
+    var sent []interface{}
+    for n := 0; n < burstVotes; n++ {
+        handle := fmt.Sprintf("fresh%d", n)
+        run(s, &proto.OnboardUser{UserHandle: handle})
+        _, sent = run(s, &proto.Reaction{UserHandle: handle, ItemId: contentId, IsContent: true, IsPositive: true})
+    }
+    var flagged []string
+    for _, msg := range sent {
+        if flag, ok := msg.(*proto.FlagVoter); ok {
+            flagged = append(flagged, flag.UserHandle)
+        }
+    }
+    if fmt.Sprint(flagged) != "[fresh0 fresh1 fresh2 fresh3 fresh4]" {
+        t.Errorf("reported %v, want every fresh account", flagged)
+    }
+
+    // A replayed vote leaves reporting to the journaled flags
+    msg := &proto.Reaction{UserHandle: "fresh0", ItemId: contentId, IsContent: true, IsPositive: true, Retract: true}
+    context := &testContext{}
+    s.handle(context, s.record(msg), msg, true)
+    msg = &proto.Reaction{UserHandle: "fresh0", ItemId: contentId, IsContent: true, IsPositive: true}
+    s.handle(context, s.record(msg), msg, true)
+    for _, sent := range context.sent {
+        if _, ok := sent.(*proto.FlagVoter); ok {
+            t.Errorf("replayed vote reported %v", sent)
+        }
+    }
+}
@@ -0,0 +1,1802 @@
+// proto/messages.proto
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.29.0--rc3
+// source: proto/messages.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Reddit_OnboardUser_FullMethodName           = "/proto.Reddit/OnboardUser"
+	Reddit_UpdateActivity_FullMethodName        = "/proto.Reddit/UpdateActivity"
+	Reddit_GetUserProfile_FullMethodName        = "/proto.Reddit/GetUserProfile"
+	Reddit_CreateForum_FullMethodName           = "/proto.Reddit/CreateForum"
+	Reddit_JoinForum_FullMethodName             = "/proto.Reddit/JoinForum"
+	Reddit_LeaveForum_FullMethodName            = "/proto.Reddit/LeaveForum"
+	Reddit_GetForumDetails_FullMethodName       = "/proto.Reddit/GetForumDetails"
+	Reddit_UpdateForumSettings_FullMethodName   = "/proto.Reddit/UpdateForumSettings"
+	Reddit_CreateContent_FullMethodName         = "/proto.Reddit/CreateContent"
+	Reddit_GetPost_FullMethodName               = "/proto.Reddit/GetPost"
+	Reddit_GetCrossposts_FullMethodName         = "/proto.Reddit/GetCrossposts"
+	Reddit_GetMedia_FullMethodName              = "/proto.Reddit/GetMedia"
+	Reddit_EditContent_FullMethodName           = "/proto.Reddit/EditContent"
+	Reddit_DeleteContent_FullMethodName         = "/proto.Reddit/DeleteContent"
+	Reddit_CreateFeedback_FullMethodName        = "/proto.Reddit/CreateFeedback"
+	Reddit_GetFeedback_FullMethodName           = "/proto.Reddit/GetFeedback"
+	Reddit_GetCommentTree_FullMethodName        = "/proto.Reddit/GetCommentTree"
+	Reddit_EditFeedback_FullMethodName          = "/proto.Reddit/EditFeedback"
+	Reddit_DeleteFeedback_FullMethodName        = "/proto.Reddit/DeleteFeedback"
+	Reddit_React_FullMethodName                 = "/proto.Reddit/React"
+	Reddit_GetFeed_FullMethodName               = "/proto.Reddit/GetFeed"
+	Reddit_GetFrontPage_FullMethodName          = "/proto.Reddit/GetFrontPage"
+	Reddit_Search_FullMethodName                = "/proto.Reddit/Search"
+	Reddit_AppointModerator_FullMethodName      = "/proto.Reddit/AppointModerator"
+	Reddit_RemoveModerator_FullMethodName       = "/proto.Reddit/RemoveModerator"
+	Reddit_BanUser_FullMethodName               = "/proto.Reddit/BanUser"
+	Reddit_UnbanUser_FullMethodName             = "/proto.Reddit/UnbanUser"
+	Reddit_RemoveContent_FullMethodName         = "/proto.Reddit/RemoveContent"
+	Reddit_RemoveFeedback_FullMethodName        = "/proto.Reddit/RemoveFeedback"
+	Reddit_LockContent_FullMethodName           = "/proto.Reddit/LockContent"
+	Reddit_InviteToForum_FullMethodName         = "/proto.Reddit/InviteToForum"
+	Reddit_RequestToJoin_FullMethodName         = "/proto.Reddit/RequestToJoin"
+	Reddit_ReviewJoinRequest_FullMethodName     = "/proto.Reddit/ReviewJoinRequest"
+	Reddit_GetJoinRequests_FullMethodName       = "/proto.Reddit/GetJoinRequests"
+	Reddit_GetFlaggedVoters_FullMethodName      = "/proto.Reddit/GetFlaggedVoters"
+	Reddit_ClearVoteFlag_FullMethodName         = "/proto.Reddit/ClearVoteFlag"
+	Reddit_SendMessage_FullMethodName           = "/proto.Reddit/SendMessage"
+	Reddit_GetChats_FullMethodName              = "/proto.Reddit/GetChats"
+	Reddit_GetConversations_FullMethodName      = "/proto.Reddit/GetConversations"
+	Reddit_GetConversation_FullMethodName       = "/proto.Reddit/GetConversation"
+	Reddit_MarkRead_FullMethodName              = "/proto.Reddit/MarkRead"
+	Reddit_GetNotifications_FullMethodName      = "/proto.Reddit/GetNotifications"
+	Reddit_MarkNotificationsRead_FullMethodName = "/proto.Reddit/MarkNotificationsRead"
+	Reddit_WatchFeed_FullMethodName             = "/proto.Reddit/WatchFeed"
+	Reddit_WatchInbox_FullMethodName            = "/proto.Reddit/WatchInbox"
+)
+
+// RedditClient is the client API for Reddit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RedditClient interface {
+	OnboardUser(ctx context.Context, in *OnboardUser, opts ...grpc.CallOption) (*OnboardUserResponse, error)
+	UpdateActivity(ctx context.Context, in *ActivityStatus, opts ...grpc.CallOption) (*ActivityStatusResponse, error)
+	GetUserProfile(ctx context.Context, in *GetUserProfile, opts ...grpc.CallOption) (*UserProfile, error)
+	CreateForum(ctx context.Context, in *CreateForum, opts ...grpc.CallOption) (*CreateForumResponse, error)
+	JoinForum(ctx context.Context, in *JoinForum, opts ...grpc.CallOption) (*JoinForumResponse, error)
+	LeaveForum(ctx context.Context, in *LeaveForum, opts ...grpc.CallOption) (*LeaveForumResponse, error)
+	GetForumDetails(ctx context.Context, in *GetForumDetails, opts ...grpc.CallOption) (*ForumDetails, error)
+	UpdateForumSettings(ctx context.Context, in *UpdateForumSettings, opts ...grpc.CallOption) (*ModerationResponse, error)
+	CreateContent(ctx context.Context, in *CreateContent, opts ...grpc.CallOption) (*CreateContentResponse, error)
+	GetPost(ctx context.Context, in *GetPost, opts ...grpc.CallOption) (*GetPostResponse, error)
+	GetCrossposts(ctx context.Context, in *GetCrossposts, opts ...grpc.CallOption) (*CrosspostList, error)
+	GetMedia(ctx context.Context, in *GetMedia, opts ...grpc.CallOption) (*MediaAccess, error)
+	EditContent(ctx context.Context, in *EditContent, opts ...grpc.CallOption) (*EditResponse, error)
+	DeleteContent(ctx context.Context, in *DeleteContent, opts ...grpc.CallOption) (*EditResponse, error)
+	CreateFeedback(ctx context.Context, in *CreateFeedback, opts ...grpc.CallOption) (*CreateFeedbackResponse, error)
+	GetFeedback(ctx context.Context, in *GetFeedback, opts ...grpc.CallOption) (*GetFeedbackResponse, error)
+	GetCommentTree(ctx context.Context, in *GetCommentTree, opts ...grpc.CallOption) (*CommentTree, error)
+	EditFeedback(ctx context.Context, in *EditFeedback, opts ...grpc.CallOption) (*EditResponse, error)
+	DeleteFeedback(ctx context.Context, in *DeleteFeedback, opts ...grpc.CallOption) (*EditResponse, error)
+	React(ctx context.Context, in *Reaction, opts ...grpc.CallOption) (*ReactionResponse, error)
+	GetFeed(ctx context.Context, in *GetFeed, opts ...grpc.CallOption) (*FeedBundle, error)
+	GetFrontPage(ctx context.Context, in *GetFrontPage, opts ...grpc.CallOption) (*FeedBundle, error)
+	Search(ctx context.Context, in *Search, opts ...grpc.CallOption) (*SearchResults, error)
+	AppointModerator(ctx context.Context, in *AppointModerator, opts ...grpc.CallOption) (*ModerationResponse, error)
+	RemoveModerator(ctx context.Context, in *RemoveModerator, opts ...grpc.CallOption) (*ModerationResponse, error)
+	BanUser(ctx context.Context, in *BanUser, opts ...grpc.CallOption) (*ModerationResponse, error)
+	UnbanUser(ctx context.Context, in *UnbanUser, opts ...grpc.CallOption) (*ModerationResponse, error)
+	RemoveContent(ctx context.Context, in *RemoveContent, opts ...grpc.CallOption) (*ModerationResponse, error)
+	RemoveFeedback(ctx context.Context, in *RemoveFeedback, opts ...grpc.CallOption) (*ModerationResponse, error)
+	LockContent(ctx context.Context, in *LockContent, opts ...grpc.CallOption) (*ModerationResponse, error)
+	InviteToForum(ctx context.Context, in *InviteToForum, opts ...grpc.CallOption) (*ModerationResponse, error)
+	RequestToJoin(ctx context.Context, in *RequestToJoin, opts ...grpc.CallOption) (*JoinForumResponse, error)
+	ReviewJoinRequest(ctx context.Context, in *ReviewJoinRequest, opts ...grpc.CallOption) (*ModerationResponse, error)
+	GetJoinRequests(ctx context.Context, in *GetJoinRequests, opts ...grpc.CallOption) (*JoinRequestList, error)
+	GetFlaggedVoters(ctx context.Context, in *GetFlaggedVoters, opts ...grpc.CallOption) (*FlaggedVoterList, error)
+	ClearVoteFlag(ctx context.Context, in *ClearVoteFlag, opts ...grpc.CallOption) (*ModerationResponse, error)
+	SendMessage(ctx context.Context, in *DirectChat, opts ...grpc.CallOption) (*ChatResponse, error)
+	GetChats(ctx context.Context, in *GetChats, opts ...grpc.CallOption) (*ChatBundle, error)
+	GetConversations(ctx context.Context, in *GetConversations, opts ...grpc.CallOption) (*ConversationList, error)
+	GetConversation(ctx context.Context, in *GetConversation, opts ...grpc.CallOption) (*ChatBundle, error)
+	MarkRead(ctx context.Context, in *MarkRead, opts ...grpc.CallOption) (*MarkReadResponse, error)
+	GetNotifications(ctx context.Context, in *GetNotifications, opts ...grpc.CallOption) (*NotificationList, error)
+	MarkNotificationsRead(ctx context.Context, in *MarkNotificationsRead, opts ...grpc.CallOption) (*MarkReadResponse, error)
+	// WatchFeed streams new posts in the user's forums, and comments and
+	// score changes on the given posts
+	WatchFeed(ctx context.Context, in *WatchFeed, opts ...grpc.CallOption) (Reddit_WatchFeedClient, error)
+	// WatchInbox streams messages sent to the user, read receipts for
+	// messages the user sent and the user's new notifications
+	WatchInbox(ctx context.Context, in *WatchInbox, opts ...grpc.CallOption) (Reddit_WatchInboxClient, error)
+}
+
+type redditClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRedditClient(cc grpc.ClientConnInterface) RedditClient {
+	return &redditClient{cc}
+}
+
+func (c *redditClient) OnboardUser(ctx context.Context, in *OnboardUser, opts ...grpc.CallOption) (*OnboardUserResponse, error) {
+	out := new(OnboardUserResponse)
+	err := c.cc.Invoke(ctx, Reddit_OnboardUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) UpdateActivity(ctx context.Context, in *ActivityStatus, opts ...grpc.CallOption) (*ActivityStatusResponse, error) {
+	out := new(ActivityStatusResponse)
+	err := c.cc.Invoke(ctx, Reddit_UpdateActivity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) GetUserProfile(ctx context.Context, in *GetUserProfile, opts ...grpc.CallOption) (*UserProfile, error) {
+	out := new(UserProfile)
+	err := c.cc.Invoke(ctx, Reddit_GetUserProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) CreateForum(ctx context.Context, in *CreateForum, opts ...grpc.CallOption) (*CreateForumResponse, error) {
+	out := new(CreateForumResponse)
+	err := c.cc.Invoke(ctx, Reddit_CreateForum_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) JoinForum(ctx context.Context, in *JoinForum, opts ...grpc.CallOption) (*JoinForumResponse, error) {
+	out := new(JoinForumResponse)
+	err := c.cc.Invoke(ctx, Reddit_JoinForum_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) LeaveForum(ctx context.Context, in *LeaveForum, opts ...grpc.CallOption) (*LeaveForumResponse, error) {
+	out := new(LeaveForumResponse)
+	err := c.cc.Invoke(ctx, Reddit_LeaveForum_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) GetForumDetails(ctx context.Context, in *GetForumDetails, opts ...grpc.CallOption) (*ForumDetails, error) {
+	out := new(ForumDetails)
+	err := c.cc.Invoke(ctx, Reddit_GetForumDetails_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) UpdateForumSettings(ctx context.Context, in *UpdateForumSettings, opts ...grpc.CallOption) (*ModerationResponse, error) {
+	out := new(ModerationResponse)
+	err := c.cc.Invoke(ctx, Reddit_UpdateForumSettings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) CreateContent(ctx context.Context, in *CreateContent, opts ...grpc.CallOption) (*CreateContentResponse, error) {
+	out := new(CreateContentResponse)
+	err := c.cc.Invoke(ctx, Reddit_CreateContent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) GetPost(ctx context.Context, in *GetPost, opts ...grpc.CallOption) (*GetPostResponse, error) {
+	out := new(GetPostResponse)
+	err := c.cc.Invoke(ctx, Reddit_GetPost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) GetCrossposts(ctx context.Context, in *GetCrossposts, opts ...grpc.CallOption) (*CrosspostList, error) {
+	out := new(CrosspostList)
+	err := c.cc.Invoke(ctx, Reddit_GetCrossposts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) GetMedia(ctx context.Context, in *GetMedia, opts ...grpc.CallOption) (*MediaAccess, error) {
+	out := new(MediaAccess)
+	err := c.cc.Invoke(ctx, Reddit_GetMedia_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) EditContent(ctx context.Context, in *EditContent, opts ...grpc.CallOption) (*EditResponse, error) {
+	out := new(EditResponse)
+	err := c.cc.Invoke(ctx, Reddit_EditContent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) DeleteContent(ctx context.Context, in *DeleteContent, opts ...grpc.CallOption) (*EditResponse, error) {
+	out := new(EditResponse)
+	err := c.cc.Invoke(ctx, Reddit_DeleteContent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) CreateFeedback(ctx context.Context, in *CreateFeedback, opts ...grpc.CallOption) (*CreateFeedbackResponse, error) {
+	out := new(CreateFeedbackResponse)
+	err := c.cc.Invoke(ctx, Reddit_CreateFeedback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) GetFeedback(ctx context.Context, in *GetFeedback, opts ...grpc.CallOption) (*GetFeedbackResponse, error) {
+	out := new(GetFeedbackResponse)
+	err := c.cc.Invoke(ctx, Reddit_GetFeedback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) GetCommentTree(ctx context.Context, in *GetCommentTree, opts ...grpc.CallOption) (*CommentTree, error) {
+	out := new(CommentTree)
+	err := c.cc.Invoke(ctx, Reddit_GetCommentTree_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) EditFeedback(ctx context.Context, in *EditFeedback, opts ...grpc.CallOption) (*EditResponse, error) {
+	out := new(EditResponse)
+	err := c.cc.Invoke(ctx, Reddit_EditFeedback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) DeleteFeedback(ctx context.Context, in *DeleteFeedback, opts ...grpc.CallOption) (*EditResponse, error) {
+	out := new(EditResponse)
+	err := c.cc.Invoke(ctx, Reddit_DeleteFeedback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) React(ctx context.Context, in *Reaction, opts ...grpc.CallOption) (*ReactionResponse, error) {
+	out := new(ReactionResponse)
+	err := c.cc.Invoke(ctx, Reddit_React_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) GetFeed(ctx context.Context, in *GetFeed, opts ...grpc.CallOption) (*FeedBundle, error) {
+	out := new(FeedBundle)
+	err := c.cc.Invoke(ctx, Reddit_GetFeed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) GetFrontPage(ctx context.Context, in *GetFrontPage, opts ...grpc.CallOption) (*FeedBundle, error) {
+	out := new(FeedBundle)
+	err := c.cc.Invoke(ctx, Reddit_GetFrontPage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) Search(ctx context.Context, in *Search, opts ...grpc.CallOption) (*SearchResults, error) {
+	out := new(SearchResults)
+	err := c.cc.Invoke(ctx, Reddit_Search_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) AppointModerator(ctx context.Context, in *AppointModerator, opts ...grpc.CallOption) (*ModerationResponse, error) {
+	out := new(ModerationResponse)
+	err := c.cc.Invoke(ctx, Reddit_AppointModerator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) RemoveModerator(ctx context.Context, in *RemoveModerator, opts ...grpc.CallOption) (*ModerationResponse, error) {
+	out := new(ModerationResponse)
+	err := c.cc.Invoke(ctx, Reddit_RemoveModerator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) BanUser(ctx context.Context, in *BanUser, opts ...grpc.CallOption) (*ModerationResponse, error) {
+	out := new(ModerationResponse)
+	err := c.cc.Invoke(ctx, Reddit_BanUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) UnbanUser(ctx context.Context, in *UnbanUser, opts ...grpc.CallOption) (*ModerationResponse, error) {
+	out := new(ModerationResponse)
+	err := c.cc.Invoke(ctx, Reddit_UnbanUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) RemoveContent(ctx context.Context, in *RemoveContent, opts ...grpc.CallOption) (*ModerationResponse, error) {
+	out := new(ModerationResponse)
+	err := c.cc.Invoke(ctx, Reddit_RemoveContent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) RemoveFeedback(ctx context.Context, in *RemoveFeedback, opts ...grpc.CallOption) (*ModerationResponse, error) {
+	out := new(ModerationResponse)
+	err := c.cc.Invoke(ctx, Reddit_RemoveFeedback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) LockContent(ctx context.Context, in *LockContent, opts ...grpc.CallOption) (*ModerationResponse, error) {
+	out := new(ModerationResponse)
+	err := c.cc.Invoke(ctx, Reddit_LockContent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) InviteToForum(ctx context.Context, in *InviteToForum, opts ...grpc.CallOption) (*ModerationResponse, error) {
+	out := new(ModerationResponse)
+	err := c.cc.Invoke(ctx, Reddit_InviteToForum_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) RequestToJoin(ctx context.Context, in *RequestToJoin, opts ...grpc.CallOption) (*JoinForumResponse, error) {
+	out := new(JoinForumResponse)
+	err := c.cc.Invoke(ctx, Reddit_RequestToJoin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) ReviewJoinRequest(ctx context.Context, in *ReviewJoinRequest, opts ...grpc.CallOption) (*ModerationResponse, error) {
+	out := new(ModerationResponse)
+	err := c.cc.Invoke(ctx, Reddit_ReviewJoinRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) GetJoinRequests(ctx context.Context, in *GetJoinRequests, opts ...grpc.CallOption) (*JoinRequestList, error) {
+	out := new(JoinRequestList)
+	err := c.cc.Invoke(ctx, Reddit_GetJoinRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) GetFlaggedVoters(ctx context.Context, in *GetFlaggedVoters, opts ...grpc.CallOption) (*FlaggedVoterList, error) {
+	out := new(FlaggedVoterList)
+	err := c.cc.Invoke(ctx, Reddit_GetFlaggedVoters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) ClearVoteFlag(ctx context.Context, in *ClearVoteFlag, opts ...grpc.CallOption) (*ModerationResponse, error) {
+	out := new(ModerationResponse)
+	err := c.cc.Invoke(ctx, Reddit_ClearVoteFlag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) SendMessage(ctx context.Context, in *DirectChat, opts ...grpc.CallOption) (*ChatResponse, error) {
+	out := new(ChatResponse)
+	err := c.cc.Invoke(ctx, Reddit_SendMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) GetChats(ctx context.Context, in *GetChats, opts ...grpc.CallOption) (*ChatBundle, error) {
+	out := new(ChatBundle)
+	err := c.cc.Invoke(ctx, Reddit_GetChats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) GetConversations(ctx context.Context, in *GetConversations, opts ...grpc.CallOption) (*ConversationList, error) {
+	out := new(ConversationList)
+	err := c.cc.Invoke(ctx, Reddit_GetConversations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) GetConversation(ctx context.Context, in *GetConversation, opts ...grpc.CallOption) (*ChatBundle, error) {
+	out := new(ChatBundle)
+	err := c.cc.Invoke(ctx, Reddit_GetConversation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) MarkRead(ctx context.Context, in *MarkRead, opts ...grpc.CallOption) (*MarkReadResponse, error) {
+	out := new(MarkReadResponse)
+	err := c.cc.Invoke(ctx, Reddit_MarkRead_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) GetNotifications(ctx context.Context, in *GetNotifications, opts ...grpc.CallOption) (*NotificationList, error) {
+	out := new(NotificationList)
+	err := c.cc.Invoke(ctx, Reddit_GetNotifications_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) MarkNotificationsRead(ctx context.Context, in *MarkNotificationsRead, opts ...grpc.CallOption) (*MarkReadResponse, error) {
+	out := new(MarkReadResponse)
+	err := c.cc.Invoke(ctx, Reddit_MarkNotificationsRead_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redditClient) WatchFeed(ctx context.Context, in *WatchFeed, opts ...grpc.CallOption) (Reddit_WatchFeedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Reddit_ServiceDesc.Streams[0], Reddit_WatchFeed_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &redditWatchFeedClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Reddit_WatchFeedClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type redditWatchFeedClient struct {
+	grpc.ClientStream
+}
+
+func (x *redditWatchFeedClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *redditClient) WatchInbox(ctx context.Context, in *WatchInbox, opts ...grpc.CallOption) (Reddit_WatchInboxClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Reddit_ServiceDesc.Streams[1], Reddit_WatchInbox_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &redditWatchInboxClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Reddit_WatchInboxClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type redditWatchInboxClient struct {
+	grpc.ClientStream
+}
+
+func (x *redditWatchInboxClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RedditServer is the server API for Reddit service.
+// All implementations must embed UnimplementedRedditServer
+// for forward compatibility
+type RedditServer interface {
+	OnboardUser(context.Context, *OnboardUser) (*OnboardUserResponse, error)
+	UpdateActivity(context.Context, *ActivityStatus) (*ActivityStatusResponse, error)
+	GetUserProfile(context.Context, *GetUserProfile) (*UserProfile, error)
+	CreateForum(context.Context, *CreateForum) (*CreateForumResponse, error)
+	JoinForum(context.Context, *JoinForum) (*JoinForumResponse, error)
+	LeaveForum(context.Context, *LeaveForum) (*LeaveForumResponse, error)
+	GetForumDetails(context.Context, *GetForumDetails) (*ForumDetails, error)
+	UpdateForumSettings(context.Context, *UpdateForumSettings) (*ModerationResponse, error)
+	CreateContent(context.Context, *CreateContent) (*CreateContentResponse, error)
+	GetPost(context.Context, *GetPost) (*GetPostResponse, error)
+	GetCrossposts(context.Context, *GetCrossposts) (*CrosspostList, error)
+	GetMedia(context.Context, *GetMedia) (*MediaAccess, error)
+	EditContent(context.Context, *EditContent) (*EditResponse, error)
+	DeleteContent(context.Context, *DeleteContent) (*EditResponse, error)
+	CreateFeedback(context.Context, *CreateFeedback) (*CreateFeedbackResponse, error)
+	GetFeedback(context.Context, *GetFeedback) (*GetFeedbackResponse, error)
+	GetCommentTree(context.Context, *GetCommentTree) (*CommentTree, error)
+	EditFeedback(context.Context, *EditFeedback) (*EditResponse, error)
+	DeleteFeedback(context.Context, *DeleteFeedback) (*EditResponse, error)
+	React(context.Context, *Reaction) (*ReactionResponse, error)
+	GetFeed(context.Context, *GetFeed) (*FeedBundle, error)
+	GetFrontPage(context.Context, *GetFrontPage) (*FeedBundle, error)
+	Search(context.Context, *Search) (*SearchResults, error)
+	AppointModerator(context.Context, *AppointModerator) (*ModerationResponse, error)
+	RemoveModerator(context.Context, *RemoveModerator) (*ModerationResponse, error)
+	BanUser(context.Context, *BanUser) (*ModerationResponse, error)
+	UnbanUser(context.Context, *UnbanUser) (*ModerationResponse, error)
+	RemoveContent(context.Context, *RemoveContent) (*ModerationResponse, error)
+	RemoveFeedback(context.Context, *RemoveFeedback) (*ModerationResponse, error)
+	LockContent(context.Context, *LockContent) (*ModerationResponse, error)
+	InviteToForum(context.Context, *InviteToForum) (*ModerationResponse, error)
+	RequestToJoin(context.Context, *RequestToJoin) (*JoinForumResponse, error)
+	ReviewJoinRequest(context.Context, *ReviewJoinRequest) (*ModerationResponse, error)
+	GetJoinRequests(context.Context, *GetJoinRequests) (*JoinRequestList, error)
+	GetFlaggedVoters(context.Context, *GetFlaggedVoters) (*FlaggedVoterList, error)
+	ClearVoteFlag(context.Context, *ClearVoteFlag) (*ModerationResponse, error)
+	SendMessage(context.Context, *DirectChat) (*ChatResponse, error)
+	GetChats(context.Context, *GetChats) (*ChatBundle, error)
+	GetConversations(context.Context, *GetConversations) (*ConversationList, error)
+	GetConversation(context.Context, *GetConversation) (*ChatBundle, error)
+	MarkRead(context.Context, *MarkRead) (*MarkReadResponse, error)
+	GetNotifications(context.Context, *GetNotifications) (*NotificationList, error)
+	MarkNotificationsRead(context.Context, *MarkNotificationsRead) (*MarkReadResponse, error)
+	// WatchFeed streams new posts in the user's forums, and comments and
+	// score changes on the given posts
+	WatchFeed(*WatchFeed, Reddit_WatchFeedServer) error
+	// WatchInbox streams messages sent to the user, read receipts for
+	// messages the user sent and the user's new notifications
+	WatchInbox(*WatchInbox, Reddit_WatchInboxServer) error
+	mustEmbedUnimplementedRedditServer()
+}
+
+// UnimplementedRedditServer must be embedded to have forward compatible implementations.
+type UnimplementedRedditServer struct {
+}
+
+func (UnimplementedRedditServer) OnboardUser(context.Context, *OnboardUser) (*OnboardUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnboardUser not implemented")
+}
+func (UnimplementedRedditServer) UpdateActivity(context.Context, *ActivityStatus) (*ActivityStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateActivity not implemented")
+}
+func (UnimplementedRedditServer) GetUserProfile(context.Context, *GetUserProfile) (*UserProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserProfile not implemented")
+}
+func (UnimplementedRedditServer) CreateForum(context.Context, *CreateForum) (*CreateForumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateForum not implemented")
+}
+func (UnimplementedRedditServer) JoinForum(context.Context, *JoinForum) (*JoinForumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinForum not implemented")
+}
+func (UnimplementedRedditServer) LeaveForum(context.Context, *LeaveForum) (*LeaveForumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveForum not implemented")
+}
+func (UnimplementedRedditServer) GetForumDetails(context.Context, *GetForumDetails) (*ForumDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForumDetails not implemented")
+}
+func (UnimplementedRedditServer) UpdateForumSettings(context.Context, *UpdateForumSettings) (*ModerationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateForumSettings not implemented")
+}
+func (UnimplementedRedditServer) CreateContent(context.Context, *CreateContent) (*CreateContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateContent not implemented")
+}
+func (UnimplementedRedditServer) GetPost(context.Context, *GetPost) (*GetPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPost not implemented")
+}
+func (UnimplementedRedditServer) GetCrossposts(context.Context, *GetCrossposts) (*CrosspostList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCrossposts not implemented")
+}
+func (UnimplementedRedditServer) GetMedia(context.Context, *GetMedia) (*MediaAccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMedia not implemented")
+}
+func (UnimplementedRedditServer) EditContent(context.Context, *EditContent) (*EditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditContent not implemented")
+}
+func (UnimplementedRedditServer) DeleteContent(context.Context, *DeleteContent) (*EditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteContent not implemented")
+}
+func (UnimplementedRedditServer) CreateFeedback(context.Context, *CreateFeedback) (*CreateFeedbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFeedback not implemented")
+}
+func (UnimplementedRedditServer) GetFeedback(context.Context, *GetFeedback) (*GetFeedbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeedback not implemented")
+}
+func (UnimplementedRedditServer) GetCommentTree(context.Context, *GetCommentTree) (*CommentTree, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentTree not implemented")
+}
+func (UnimplementedRedditServer) EditFeedback(context.Context, *EditFeedback) (*EditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditFeedback not implemented")
+}
+func (UnimplementedRedditServer) DeleteFeedback(context.Context, *DeleteFeedback) (*EditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeedback not implemented")
+}
+func (UnimplementedRedditServer) React(context.Context, *Reaction) (*ReactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method React not implemented")
+}
+func (UnimplementedRedditServer) GetFeed(context.Context, *GetFeed) (*FeedBundle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeed not implemented")
+}
+func (UnimplementedRedditServer) GetFrontPage(context.Context, *GetFrontPage) (*FeedBundle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFrontPage not implemented")
+}
+func (UnimplementedRedditServer) Search(context.Context, *Search) (*SearchResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedRedditServer) AppointModerator(context.Context, *AppointModerator) (*ModerationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppointModerator not implemented")
+}
+func (UnimplementedRedditServer) RemoveModerator(context.Context, *RemoveModerator) (*ModerationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveModerator not implemented")
+}
+func (UnimplementedRedditServer) BanUser(context.Context, *BanUser) (*ModerationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanUser not implemented")
+}
+func (UnimplementedRedditServer) UnbanUser(context.Context, *UnbanUser) (*ModerationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanUser not implemented")
+}
+func (UnimplementedRedditServer) RemoveContent(context.Context, *RemoveContent) (*ModerationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveContent not implemented")
+}
+func (UnimplementedRedditServer) RemoveFeedback(context.Context, *RemoveFeedback) (*ModerationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFeedback not implemented")
+}
+func (UnimplementedRedditServer) LockContent(context.Context, *LockContent) (*ModerationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockContent not implemented")
+}
+func (UnimplementedRedditServer) InviteToForum(context.Context, *InviteToForum) (*ModerationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteToForum not implemented")
+}
+func (UnimplementedRedditServer) RequestToJoin(context.Context, *RequestToJoin) (*JoinForumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestToJoin not implemented")
+}
+func (UnimplementedRedditServer) ReviewJoinRequest(context.Context, *ReviewJoinRequest) (*ModerationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewJoinRequest not implemented")
+}
+func (UnimplementedRedditServer) GetJoinRequests(context.Context, *GetJoinRequests) (*JoinRequestList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJoinRequests not implemented")
+}
+func (UnimplementedRedditServer) GetFlaggedVoters(context.Context, *GetFlaggedVoters) (*FlaggedVoterList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlaggedVoters not implemented")
+}
+func (UnimplementedRedditServer) ClearVoteFlag(context.Context, *ClearVoteFlag) (*ModerationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearVoteFlag not implemented")
+}
+func (UnimplementedRedditServer) SendMessage(context.Context, *DirectChat) (*ChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
+}
+func (UnimplementedRedditServer) GetChats(context.Context, *GetChats) (*ChatBundle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChats not implemented")
+}
+func (UnimplementedRedditServer) GetConversations(context.Context, *GetConversations) (*ConversationList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversations not implemented")
+}
+func (UnimplementedRedditServer) GetConversation(context.Context, *GetConversation) (*ChatBundle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversation not implemented")
+}
+func (UnimplementedRedditServer) MarkRead(context.Context, *MarkRead) (*MarkReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkRead not implemented")
+}
+func (UnimplementedRedditServer) GetNotifications(context.Context, *GetNotifications) (*NotificationList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotifications not implemented")
+}
+func (UnimplementedRedditServer) MarkNotificationsRead(context.Context, *MarkNotificationsRead) (*MarkReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNotificationsRead not implemented")
+}
+func (UnimplementedRedditServer) WatchFeed(*WatchFeed, Reddit_WatchFeedServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchFeed not implemented")
+}
+func (UnimplementedRedditServer) WatchInbox(*WatchInbox, Reddit_WatchInboxServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchInbox not implemented")
+}
+func (UnimplementedRedditServer) mustEmbedUnimplementedRedditServer() {}
+
+// UnsafeRedditServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RedditServer will
+// result in compilation errors.
+type UnsafeRedditServer interface {
+	mustEmbedUnimplementedRedditServer()
+}
+
+func RegisterRedditServer(s grpc.ServiceRegistrar, srv RedditServer) {
+	s.RegisterService(&Reddit_ServiceDesc, srv)
+}
+
+func _Reddit_OnboardUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnboardUser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).OnboardUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_OnboardUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).OnboardUser(ctx, req.(*OnboardUser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_UpdateActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivityStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).UpdateActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_UpdateActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).UpdateActivity(ctx, req.(*ActivityStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_GetUserProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserProfile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).GetUserProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_GetUserProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).GetUserProfile(ctx, req.(*GetUserProfile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_CreateForum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateForum)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).CreateForum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_CreateForum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).CreateForum(ctx, req.(*CreateForum))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_JoinForum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinForum)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).JoinForum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_JoinForum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).JoinForum(ctx, req.(*JoinForum))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_LeaveForum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveForum)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).LeaveForum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_LeaveForum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).LeaveForum(ctx, req.(*LeaveForum))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_GetForumDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForumDetails)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).GetForumDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_GetForumDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).GetForumDetails(ctx, req.(*GetForumDetails))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_UpdateForumSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateForumSettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).UpdateForumSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_UpdateForumSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).UpdateForumSettings(ctx, req.(*UpdateForumSettings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_CreateContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateContent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).CreateContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_CreateContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).CreateContent(ctx, req.(*CreateContent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_GetPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPost)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).GetPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_GetPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).GetPost(ctx, req.(*GetPost))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_GetCrossposts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCrossposts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).GetCrossposts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_GetCrossposts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).GetCrossposts(ctx, req.(*GetCrossposts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_GetMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMedia)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).GetMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_GetMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).GetMedia(ctx, req.(*GetMedia))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_EditContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditContent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).EditContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_EditContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).EditContent(ctx, req.(*EditContent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_DeleteContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteContent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).DeleteContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_DeleteContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).DeleteContent(ctx, req.(*DeleteContent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_CreateFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFeedback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).CreateFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_CreateFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).CreateFeedback(ctx, req.(*CreateFeedback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_GetFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).GetFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_GetFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).GetFeedback(ctx, req.(*GetFeedback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_GetCommentTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentTree)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).GetCommentTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_GetCommentTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).GetCommentTree(ctx, req.(*GetCommentTree))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_EditFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditFeedback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).EditFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_EditFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).EditFeedback(ctx, req.(*EditFeedback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_DeleteFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFeedback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).DeleteFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_DeleteFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).DeleteFeedback(ctx, req.(*DeleteFeedback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_React_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Reaction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).React(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_React_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).React(ctx, req.(*Reaction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_GetFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeed)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).GetFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_GetFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).GetFeed(ctx, req.(*GetFeed))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_GetFrontPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFrontPage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).GetFrontPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_GetFrontPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).GetFrontPage(ctx, req.(*GetFrontPage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Search)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).Search(ctx, req.(*Search))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_AppointModerator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppointModerator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).AppointModerator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_AppointModerator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).AppointModerator(ctx, req.(*AppointModerator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_RemoveModerator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveModerator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).RemoveModerator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_RemoveModerator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).RemoveModerator(ctx, req.(*RemoveModerator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_BanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanUser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).BanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_BanUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).BanUser(ctx, req.(*BanUser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_UnbanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanUser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).UnbanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_UnbanUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).UnbanUser(ctx, req.(*UnbanUser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_RemoveContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveContent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).RemoveContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_RemoveContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).RemoveContent(ctx, req.(*RemoveContent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_RemoveFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFeedback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).RemoveFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_RemoveFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).RemoveFeedback(ctx, req.(*RemoveFeedback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_LockContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockContent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).LockContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_LockContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).LockContent(ctx, req.(*LockContent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_InviteToForum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteToForum)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).InviteToForum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_InviteToForum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).InviteToForum(ctx, req.(*InviteToForum))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_RequestToJoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestToJoin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).RequestToJoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_RequestToJoin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).RequestToJoin(ctx, req.(*RequestToJoin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_ReviewJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewJoinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).ReviewJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_ReviewJoinRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).ReviewJoinRequest(ctx, req.(*ReviewJoinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_GetJoinRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJoinRequests)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).GetJoinRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_GetJoinRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).GetJoinRequests(ctx, req.(*GetJoinRequests))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_GetFlaggedVoters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFlaggedVoters)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).GetFlaggedVoters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_GetFlaggedVoters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).GetFlaggedVoters(ctx, req.(*GetFlaggedVoters))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_ClearVoteFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearVoteFlag)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).ClearVoteFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_ClearVoteFlag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).ClearVoteFlag(ctx, req.(*ClearVoteFlag))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DirectChat)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).SendMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_SendMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).SendMessage(ctx, req.(*DirectChat))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_GetChats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChats)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).GetChats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_GetChats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).GetChats(ctx, req.(*GetChats))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_GetConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversations)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).GetConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_GetConversations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).GetConversations(ctx, req.(*GetConversations))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_GetConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).GetConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_GetConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).GetConversation(ctx, req.(*GetConversation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_MarkRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkRead)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).MarkRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_MarkRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).MarkRead(ctx, req.(*MarkRead))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_GetNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotifications)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).GetNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_GetNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).GetNotifications(ctx, req.(*GetNotifications))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_MarkNotificationsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNotificationsRead)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedditServer).MarkNotificationsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reddit_MarkNotificationsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedditServer).MarkNotificationsRead(ctx, req.(*MarkNotificationsRead))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reddit_WatchFeed_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchFeed)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RedditServer).WatchFeed(m, &redditWatchFeedServer{stream})
+}
+
+type Reddit_WatchFeedServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type redditWatchFeedServer struct {
+	grpc.ServerStream
+}
+
+func (x *redditWatchFeedServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Reddit_WatchInbox_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchInbox)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RedditServer).WatchInbox(m, &redditWatchInboxServer{stream})
+}
+
+type Reddit_WatchInboxServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type redditWatchInboxServer struct {
+	grpc.ServerStream
+}
+
+func (x *redditWatchInboxServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Reddit_ServiceDesc is the grpc.ServiceDesc for Reddit service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Reddit_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.Reddit",
+	HandlerType: (*RedditServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OnboardUser",
+			Handler:    _Reddit_OnboardUser_Handler,
+		},
+		{
+			MethodName: "UpdateActivity",
+			Handler:    _Reddit_UpdateActivity_Handler,
+		},
+		{
+			MethodName: "GetUserProfile",
+			Handler:    _Reddit_GetUserProfile_Handler,
+		},
+		{
+			MethodName: "CreateForum",
+			Handler:    _Reddit_CreateForum_Handler,
+		},
+		{
+			MethodName: "JoinForum",
+			Handler:    _Reddit_JoinForum_Handler,
+		},
+		{
+			MethodName: "LeaveForum",
+			Handler:    _Reddit_LeaveForum_Handler,
+		},
+		{
+			MethodName: "GetForumDetails",
+			Handler:    _Reddit_GetForumDetails_Handler,
+		},
+		{
+			MethodName: "UpdateForumSettings",
+			Handler:    _Reddit_UpdateForumSettings_Handler,
+		},
+		{
+			MethodName: "CreateContent",
+			Handler:    _Reddit_CreateContent_Handler,
+		},
+		{
+			MethodName: "GetPost",
+			Handler:    _Reddit_GetPost_Handler,
+		},
+		{
+			MethodName: "GetCrossposts",
+			Handler:    _Reddit_GetCrossposts_Handler,
+		},
+		{
+			MethodName: "GetMedia",
+			Handler:    _Reddit_GetMedia_Handler,
+		},
+		{
+			MethodName: "EditContent",
+			Handler:    _Reddit_EditContent_Handler,
+		},
+		{
+			MethodName: "DeleteContent",
+			Handler:    _Reddit_DeleteContent_Handler,
+		},
+		{
+			MethodName: "CreateFeedback",
+			Handler:    _Reddit_CreateFeedback_Handler,
+		},
+		{
+			MethodName: "GetFeedback",
+			Handler:    _Reddit_GetFeedback_Handler,
+		},
+		{
+			MethodName: "GetCommentTree",
+			Handler:    _Reddit_GetCommentTree_Handler,
+		},
+		{
+			MethodName: "EditFeedback",
+			Handler:    _Reddit_EditFeedback_Handler,
+		},
+		{
+			MethodName: "DeleteFeedback",
+			Handler:    _Reddit_DeleteFeedback_Handler,
+		},
+		{
+			MethodName: "React",
+			Handler:    _Reddit_React_Handler,
+		},
+		{
+			MethodName: "GetFeed",
+			Handler:    _Reddit_GetFeed_Handler,
+		},
+		{
+			MethodName: "GetFrontPage",
+			Handler:    _Reddit_GetFrontPage_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _Reddit_Search_Handler,
+		},
+		{
+			MethodName: "AppointModerator",
+			Handler:    _Reddit_AppointModerator_Handler,
+		},
+		{
+			MethodName: "RemoveModerator",
+			Handler:    _Reddit_RemoveModerator_Handler,
+		},
+		{
+			MethodName: "BanUser",
+			Handler:    _Reddit_BanUser_Handler,
+		},
+		{
+			MethodName: "UnbanUser",
+			Handler:    _Reddit_UnbanUser_Handler,
+		},
+		{
+			MethodName: "RemoveContent",
+			Handler:    _Reddit_RemoveContent_Handler,
+		},
+		{
+			MethodName: "RemoveFeedback",
+			Handler:    _Reddit_RemoveFeedback_Handler,
+		},
+		{
+			MethodName: "LockContent",
+			Handler:    _Reddit_LockContent_Handler,
+		},
+		{
+			MethodName: "InviteToForum",
+			Handler:    _Reddit_InviteToForum_Handler,
+		},
+		{
+			MethodName: "RequestToJoin",
+			Handler:    _Reddit_RequestToJoin_Handler,
+		},
+		{
+			MethodName: "ReviewJoinRequest",
+			Handler:    _Reddit_ReviewJoinRequest_Handler,
+		},
+		{
+			MethodName: "GetJoinRequests",
+			Handler:    _Reddit_GetJoinRequests_Handler,
+		},
+		{
+			MethodName: "GetFlaggedVoters",
+			Handler:    _Reddit_GetFlaggedVoters_Handler,
+		},
+		{
+			MethodName: "ClearVoteFlag",
+			Handler:    _Reddit_ClearVoteFlag_Handler,
+		},
+		{
+			MethodName: "SendMessage",
+			Handler:    _Reddit_SendMessage_Handler,
+		},
+		{
+			MethodName: "GetChats",
+			Handler:    _Reddit_GetChats_Handler,
+		},
+		{
+			MethodName: "GetConversations",
+			Handler:    _Reddit_GetConversations_Handler,
+		},
+		{
+			MethodName: "GetConversation",
+			Handler:    _Reddit_GetConversation_Handler,
+		},
+		{
+			MethodName: "MarkRead",
+			Handler:    _Reddit_MarkRead_Handler,
+		},
+		{
+			MethodName: "GetNotifications",
+			Handler:    _Reddit_GetNotifications_Handler,
+		},
+		{
+			MethodName: "MarkNotificationsRead",
+			Handler:    _Reddit_MarkNotificationsRead_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchFeed",
+			Handler:       _Reddit_WatchFeed_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchInbox",
+			Handler:       _Reddit_WatchInbox_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/messages.proto",
+}
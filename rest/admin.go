@@ -0,0 +1,58 @@
+// rest/admin.go
+package rest
+
+import (
+    "net/http"
+    "time"
+    "github.com/gorilla/mux"
+    "reddit/proto"
+)
+
+// getFlaggedVoters reports the accounts whose votes were quarantined as
+// manipulation. Only site admins may see it.
+func (s *Server) getFlaggedVoters(w http.ResponseWriter, r *http.Request) {
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    future := s.system.Root.RequestFuture(s.engine, &proto.GetFlaggedVoters{
+        UserHandle: username,
+    }, 5*time.Second)
+
+    result, err := future.Result()
+    if err != nil {
+        sendError(w, http.StatusInternalServerError, "Failed to get flagged voters")
+        return
+    }
+
+    response, ok := result.(*proto.FlaggedVoterList)
+    if !ok {
+        sendError(w, http.StatusInternalServerError, "Failed to get flagged voters")
+        return
+    }
+    if !response.Success {
+        sendError(w, http.StatusForbidden, response.Message)
+        return
+    }
+
+    sendResponse(w, http.StatusOK, Response{
+        Success: true,
+        Message: response.Message,
+        Data:    response.Voters,
+    })
+}
+
+// clearVoteFlag lifts the quarantine on a flagged user's votes
+func (s *Server) clearVoteFlag(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    s.moderate(w, &proto.ClearVoteFlag{
+        UserHandle: username,
+        Target:     vars["username"],
+    }, "Failed to clear vote flag")
+}
@@ -0,0 +1,125 @@
+// rest/auth.go
+package rest
+
+import (
+    "context"
+    "encoding/json"
+    "net/http"
+    "strings"
+    "time"
+    "reddit/proto"
+)
+
+type contextKey string
+
+const userContextKey contextKey = "user"
+
+type LoginRequest struct {
+    Username string `json:"username"`
+    Password string `json:"password"`
+}
+
+type LoginResponse struct {
+    Token     string `json:"token"`
+    ExpiresAt int64  `json:"expiresAt"`
+}
+
+// authMiddleware resolves the caller from a bearer token. Requests without
+// a token pass through anonymously; requests with a bad token are rejected.
+func (s *Server) authMiddleware(next http.Handler) http.Handler {
+    return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        header := r.Header.Get("Authorization")
+        if header == "" {
+            next.ServeHTTP(w, r)
+            return
+        }
+
+        token := strings.TrimPrefix(header, "Bearer ")
+        if token == header {
+            sendError(w, http.StatusUnauthorized, "Authorization header must use the Bearer scheme")
+            return
+        }
+
+        username, err := s.tokens.Verify(token)
+        if err != nil {
+            sendError(w, http.StatusUnauthorized, "Invalid or expired token")
+            return
+        }
+
+        ctx := context.WithValue(r.Context(), userContextKey, username)
+        next.ServeHTTP(w, r.WithContext(ctx))
+    })
+}
+
+// currentUser returns the authenticated caller, if any
+func currentUser(r *http.Request) (string, bool) {
+    username, ok := r.Context().Value(userContextKey).(string)
+    return username, ok
+}
+
+// queryUser returns the authenticated caller, also accepting a token passed
+// as ?token= for requests browsers cannot add headers to
+func (s *Server) queryUser(r *http.Request) (string, bool) {
+    if username, ok := currentUser(r); ok {
+        return username, ok
+    }
+    if token := r.URL.Query().Get("token"); token != "" {
+        if username, err := s.tokens.Verify(token); err == nil {
+            return username, true
+        }
+    }
+    return "", false
+}
+
+// requireUser returns the authenticated caller or sends a 401
+func requireUser(w http.ResponseWriter, r *http.Request) (string, bool) {
+    username, ok := currentUser(r)
+    if !ok {
+        sendError(w, http.StatusUnauthorized, "Authentication required")
+    }
+    return username, ok
+}
+
+func (s *Server) login(w http.ResponseWriter, r *http.Request) {
+    var req LoginRequest
+    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+        sendError(w, http.StatusBadRequest, "Invalid request body")
+        return
+    }
+
+    future := s.system.Root.RequestFuture(s.engine, &proto.CheckPassword{
+        UserHandle: req.Username,
+        Password:   req.Password,
+    }, 5*time.Second)
+
+    result, err := future.Result()
+    if err != nil {
+        sendError(w, http.StatusInternalServerError, "Failed to log in")
+        return
+    }
+
+    response, ok := result.(*proto.PasswordCheck)
+    if !ok {
+        sendError(w, http.StatusInternalServerError, "Failed to log in")
+        return
+    }
+    if !response.Success {
+        sendError(w, http.StatusUnauthorized, response.Message)
+        return
+    }
+
+    token, expiresAt, err := s.tokens.Issue(req.Username)
+    if err != nil {
+        sendError(w, http.StatusInternalServerError, "Failed to issue token")
+        return
+    }
+
+    sendResponse(w, http.StatusOK, Response{
+        Success: true,
+        Message: "Logged in successfully",
+        Data: LoginResponse{
+            Token:     token,
+            ExpiresAt: expiresAt.Unix(),
+        },
+    })
+}
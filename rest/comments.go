@@ -0,0 +1,138 @@
+// rest/comments.go
+package rest
+
+import (
+    "encoding/json"
+    "net/http"
+    "strconv"
+    "time"
+    "github.com/gorilla/mux"
+    "reddit/proto"
+)
+
+type ReplyRequest struct {
+    Content string `json:"content"`
+}
+
+// getComment returns a comment with its replies nested below it, shaped
+// like a post's comment tree
+func (s *Server) getComment(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    s.fetchCommentTree(w, r, &proto.GetCommentTree{FeedbackId: vars["commentId"]})
+}
+
+func (s *Server) voteComment(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    s.react(w, r, vars["commentId"], false, false)
+}
+
+func (s *Server) unvoteComment(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    s.react(w, r, vars["commentId"], false, true)
+}
+
+func (s *Server) replyToComment(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    var req ReplyRequest
+    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+        sendError(w, http.StatusBadRequest, "Invalid request body")
+        return
+    }
+
+    future := s.system.Root.RequestFuture(s.engine, &proto.CreateFeedback{
+        UserHandle: username,
+        ParentId:   vars["commentId"],
+        Body:       req.Content,
+    }, 5*time.Second)
+
+    result, err := future.Result()
+    if err != nil {
+        sendError(w, http.StatusInternalServerError, "Failed to create reply")
+        return
+    }
+
+    response, ok := result.(*proto.CreateFeedbackResponse)
+    if !ok {
+        sendError(w, http.StatusInternalServerError, "Failed to create reply")
+        return
+    }
+    if !response.Success {
+        sendError(w, http.StatusInternalServerError, response.Message)
+        return
+    }
+
+    sendResponse(w, http.StatusCreated, Response{
+        Success: true,
+        Message: response.Message,
+        Data: map[string]string{
+            "feedbackId": response.FeedbackId,
+        },
+    })
+}
+
+// getPostComments returns a post's comment tree
+func (s *Server) getPostComments(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    s.fetchCommentTree(w, r, &proto.GetCommentTree{ContentId: vars["postId"]})
+}
+
+// fetchCommentTree fills in a comment tree request from the query and
+// returns the tree. ?depth= and ?limit= bound how deep and how wide it goes;
+// branches left out come back with a continuation to pass as ?continue= to
+// load them.
+func (s *Server) fetchCommentTree(w http.ResponseWriter, r *http.Request, msg *proto.GetCommentTree) {
+    query := r.URL.Query()
+    viewer, _ := currentUser(r)
+
+    bounds := make(map[string]int32)
+    for _, name := range []string{"depth", "limit"} {
+        raw := query.Get(name)
+        if raw == "" {
+            continue
+        }
+        value, err := strconv.ParseInt(raw, 10, 32)
+        if err != nil || value <= 0 {
+            sendError(w, http.StatusBadRequest, "Depth and limit must be positive numbers")
+            return
+        }
+        bounds[name] = int32(value)
+    }
+
+    msg.SortMethod = query.Get("sort")
+    msg.MaxDepth = bounds["depth"]
+    msg.MaxChildren = bounds["limit"]
+    msg.Continuation = query.Get("continue")
+    msg.Viewer = viewer
+    future := s.system.Root.RequestFuture(s.engine, msg, 5*time.Second)
+
+    result, err := future.Result()
+    if err != nil {
+        sendError(w, http.StatusInternalServerError, "Failed to get comments")
+        return
+    }
+
+    response, ok := result.(*proto.CommentTree)
+    if !ok {
+        sendError(w, http.StatusInternalServerError, "Failed to get comments")
+        return
+    }
+    if !response.Success {
+        status := http.StatusBadRequest
+        if response.Message == "Post not found" || response.Message == "Comment not found" {
+            status = http.StatusNotFound
+        }
+        sendError(w, status, response.Message)
+        return
+    }
+
+    sendResponse(w, http.StatusOK, Response{
+        Success: true,
+        Message: response.Message,
+        Data:    response,
+    })
+}
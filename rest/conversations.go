@@ -0,0 +1,160 @@
+// rest/conversations.go
+package rest
+
+import (
+    "encoding/json"
+    "net/http"
+    "time"
+    "github.com/gorilla/mux"
+    "reddit/proto"
+)
+
+type ConversationMessageRequest struct {
+    Content string `json:"content"`
+}
+
+type MarkReadRequest struct {
+    MessageId string `json:"messageId"`
+}
+
+func (s *Server) getConversations(w http.ResponseWriter, r *http.Request) {
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    future := s.system.Root.RequestFuture(s.engine, &proto.GetConversations{
+        UserHandle: username,
+    }, 5*time.Second)
+
+    result, err := future.Result()
+    if err != nil {
+        sendError(w, http.StatusInternalServerError, "Failed to get conversations")
+        return
+    }
+
+    response, ok := result.(*proto.ConversationList)
+    if !ok {
+        sendError(w, http.StatusInternalServerError, "Failed to get conversations")
+        return
+    }
+    if !response.Success {
+        sendError(w, http.StatusInternalServerError, response.Message)
+        return
+    }
+
+    sendResponse(w, http.StatusOK, Response{
+        Success: true,
+        Message: response.Message,
+        Data:    response,
+    })
+}
+
+func (s *Server) getConversation(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    page, ok := pageParams(w, r)
+    if !ok {
+        return
+    }
+
+    future := s.system.Root.RequestFuture(s.engine, &proto.GetConversation{
+        UserHandle: username,
+        Peer:       vars["peer"],
+        After:      page.After,
+        Before:     page.Before,
+        Limit:      page.Limit,
+    }, 5*time.Second)
+
+    result, err := future.Result()
+    if err != nil {
+        sendError(w, http.StatusInternalServerError, "Failed to get conversation")
+        return
+    }
+
+    response, ok := result.(*proto.ChatBundle)
+    if !ok {
+        sendError(w, http.StatusInternalServerError, "Failed to get conversation")
+        return
+    }
+    if !response.Success {
+        sendError(w, http.StatusInternalServerError, response.Message)
+        return
+    }
+
+    sendResponse(w, http.StatusOK, Response{
+        Success:    true,
+        Message:    response.Message,
+        Data:       response.Messages,
+        NextCursor: response.NextCursor,
+        PrevCursor: response.PrevCursor,
+    })
+}
+
+func (s *Server) replyInConversation(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    var req ConversationMessageRequest
+    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+        sendError(w, http.StatusBadRequest, "Invalid request body")
+        return
+    }
+
+    s.deliver(w, username, vars["peer"], req.Content)
+}
+
+// markRead marks the peer's messages as read, up to messageId if given
+func (s *Server) markRead(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    // The body is optional; without one every message is marked
+    var req MarkReadRequest
+    if r.ContentLength > 0 {
+        if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+            sendError(w, http.StatusBadRequest, "Invalid request body")
+            return
+        }
+    }
+
+    future := s.system.Root.RequestFuture(s.engine, &proto.MarkRead{
+        UserHandle: username,
+        Peer:       vars["peer"],
+        MessageId:  req.MessageId,
+    }, 5*time.Second)
+
+    result, err := future.Result()
+    if err != nil {
+        sendError(w, http.StatusInternalServerError, "Failed to mark messages as read")
+        return
+    }
+
+    response, ok := result.(*proto.MarkReadResponse)
+    if !ok {
+        sendError(w, http.StatusInternalServerError, "Failed to mark messages as read")
+        return
+    }
+    if !response.Success {
+        sendError(w, http.StatusBadRequest, response.Message)
+        return
+    }
+
+    sendResponse(w, http.StatusOK, Response{
+        Success: true,
+        Message: response.Message,
+        Data: map[string]int32{
+            "marked": response.Marked,
+        },
+    })
+}
@@ -0,0 +1,51 @@
+// rest/crossposts.go
+package rest
+
+import (
+    "net/http"
+    "time"
+    "github.com/gorilla/mux"
+    "reddit/proto"
+)
+
+func (s *Server) getCrossposts(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    viewer, _ := currentUser(r)
+
+    page, ok := pageParams(w, r)
+    if !ok {
+        return
+    }
+
+    future := s.system.Root.RequestFuture(s.engine, &proto.GetCrossposts{
+        ContentId: vars["postId"],
+        Viewer:    viewer,
+        After:     page.After,
+        Before:    page.Before,
+        Limit:     page.Limit,
+    }, 5*time.Second)
+
+    result, err := future.Result()
+    if err != nil {
+        sendError(w, http.StatusInternalServerError, "Failed to get crossposts")
+        return
+    }
+
+    response, ok := result.(*proto.CrosspostList)
+    if !ok {
+        sendError(w, http.StatusInternalServerError, "Failed to get crossposts")
+        return
+    }
+    if !response.Success {
+        sendError(w, http.StatusNotFound, response.Message)
+        return
+    }
+
+    sendResponse(w, http.StatusOK, Response{
+        Success:    true,
+        Message:    response.Message,
+        Data:       response.Contents,
+        NextCursor: response.NextCursor,
+        PrevCursor: response.PrevCursor,
+    })
+}
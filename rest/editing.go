@@ -0,0 +1,112 @@
+// rest/editing.go
+package rest
+
+import (
+    "encoding/json"
+    "net/http"
+    "time"
+    "github.com/gorilla/mux"
+    "reddit/proto"
+)
+
+type EditPostRequest struct {
+    Title   string `json:"title"`
+    Content string `json:"content"`
+}
+
+type EditCommentRequest struct {
+    Content string `json:"content"`
+}
+
+// edit sends an edit or delete command to the engine and writes the outcome
+func (s *Server) edit(w http.ResponseWriter, msg interface{}, failure string) {
+    future := s.system.Root.RequestFuture(s.engine, msg, 5*time.Second)
+
+    result, err := future.Result()
+    if err != nil {
+        sendError(w, http.StatusInternalServerError, failure)
+        return
+    }
+
+    response, ok := result.(*proto.EditResponse)
+    if !ok {
+        sendError(w, http.StatusInternalServerError, failure)
+        return
+    }
+    if !response.Success {
+        sendError(w, http.StatusForbidden, response.Message)
+        return
+    }
+
+    sendResponse(w, http.StatusOK, Response{
+        Success: true,
+        Message: response.Message,
+    })
+}
+
+func (s *Server) editPost(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    var req EditPostRequest
+    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+        sendError(w, http.StatusBadRequest, "Invalid request body")
+        return
+    }
+
+    s.edit(w, &proto.EditContent{
+        UserHandle: username,
+        ContentId:  vars["postId"],
+        Heading:    req.Title,
+        Body:       req.Content,
+    }, "Failed to edit post")
+}
+
+func (s *Server) deletePost(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    s.edit(w, &proto.DeleteContent{
+        UserHandle: username,
+        ContentId:  vars["postId"],
+    }, "Failed to delete post")
+}
+
+func (s *Server) editComment(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    var req EditCommentRequest
+    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+        sendError(w, http.StatusBadRequest, "Invalid request body")
+        return
+    }
+
+    s.edit(w, &proto.EditFeedback{
+        UserHandle: username,
+        FeedbackId: vars["commentId"],
+        Body:       req.Content,
+    }, "Failed to edit comment")
+}
+
+func (s *Server) deleteComment(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    s.edit(w, &proto.DeleteFeedback{
+        UserHandle: username,
+        FeedbackId: vars["commentId"],
+    }, "Failed to delete comment")
+}
@@ -0,0 +1,103 @@
+// rest/events.go
+package rest
+
+import (
+    "log"
+    "net/http"
+    "strings"
+    "time"
+    "github.com/gorilla/websocket"
+    "reddit/engine"
+    "reddit/proto"
+)
+
+const (
+    pingInterval = 30 * time.Second
+    writeTimeout = 10 * time.Second
+)
+
+var upgrader = websocket.Upgrader{
+    // The API already allows any origin, see corsMiddleware
+    CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// SubscriptionRequest is sent by clients over the socket to start or stop
+// watching a post
+type SubscriptionRequest struct {
+    Action string `json:"action"`
+    PostId string `json:"postId"`
+}
+
+// streamEvents upgrades the request to a WebSocket and pushes new posts in
+// the caller's forums, messages and notifications for the caller and
+// activity on watched posts.
+// Browsers cannot set headers on WebSockets, so the token may also be passed
+// as ?token=.
+func (s *Server) streamEvents(w http.ResponseWriter, r *http.Request) {
+    username, ok := s.queryUser(r)
+    if !ok {
+        sendError(w, http.StatusUnauthorized, "Authentication required")
+        return
+    }
+
+    var posts []string
+    if raw := r.URL.Query().Get("posts"); raw != "" {
+        posts = strings.Split(raw, ",")
+    }
+
+    conn, err := upgrader.Upgrade(w, r, nil)
+    if err != nil {
+        log.Printf("Failed to upgrade connection for %s: %v", username, err)
+        return
+    }
+    defer conn.Close()
+
+    props, events := engine.NewSubscriber(s.engine, username, posts)
+    pid := s.system.Root.Spawn(props)
+    defer s.system.Root.Stop(pid)
+
+    // Reads run on their own goroutine; a read error means the client is gone
+    closed := make(chan struct{})
+    go func() {
+        defer close(closed)
+        for {
+            var req SubscriptionRequest
+            if err := conn.ReadJSON(&req); err != nil {
+                return
+            }
+            switch req.Action {
+            case "subscribe":
+                s.system.Root.Send(pid, &proto.Subscribe{
+                    UserHandle: username,
+                    ContentIds: []string{req.PostId},
+                })
+            case "unsubscribe":
+                s.system.Root.Send(pid, &proto.Unsubscribe{
+                    ContentIds: []string{req.PostId},
+                })
+            }
+        }
+    }()
+
+    log.Printf("Event stream opened for %s", username)
+    ping := time.NewTicker(pingInterval)
+    defer ping.Stop()
+    for {
+        select {
+        case event := <-events:
+            conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+            if err := conn.WriteJSON(event); err != nil {
+                log.Printf("Event stream for %s failed: %v", username, err)
+                return
+            }
+        case <-ping.C:
+            conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+            if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+                return
+            }
+        case <-closed:
+            log.Printf("Event stream closed for %s", username)
+            return
+        }
+    }
+}
@@ -0,0 +1,136 @@
+// rest/forum_access.go
+package rest
+
+import (
+    "encoding/json"
+    "net/http"
+    "time"
+    "github.com/gorilla/mux"
+    "reddit/proto"
+)
+
+type JoinRequestRequest struct {
+    Message string `json:"message"`
+}
+
+func (s *Server) inviteToForum(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    var req ModeratorRequest
+    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+        sendError(w, http.StatusBadRequest, "Invalid request body")
+        return
+    }
+
+    s.moderate(w, &proto.InviteToForum{
+        UserHandle: username,
+        Subreddit:  vars["forumName"],
+        Target:     req.Username,
+    }, "Failed to invite user")
+}
+
+func (s *Server) requestToJoin(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    // The message to the moderators is optional
+    var req JoinRequestRequest
+    if r.ContentLength != 0 {
+        if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+            sendError(w, http.StatusBadRequest, "Invalid request body")
+            return
+        }
+    }
+
+    future := s.system.Root.RequestFuture(s.engine, &proto.RequestToJoin{
+        UserHandle: username,
+        Subreddit:  vars["forumName"],
+        Message:    req.Message,
+    }, 5*time.Second)
+
+    result, err := future.Result()
+    if err != nil {
+        sendError(w, http.StatusInternalServerError, "Failed to request to join")
+        return
+    }
+
+    response, ok := result.(*proto.JoinForumResponse)
+    if !ok {
+        sendError(w, http.StatusInternalServerError, "Failed to request to join")
+        return
+    }
+    if !response.Success {
+        sendError(w, http.StatusBadRequest, response.Message)
+        return
+    }
+
+    sendResponse(w, http.StatusAccepted, Response{
+        Success: true,
+        Message: response.Message,
+    })
+}
+
+func (s *Server) getJoinRequests(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    future := s.system.Root.RequestFuture(s.engine, &proto.GetJoinRequests{
+        UserHandle: username,
+        Subreddit:  vars["forumName"],
+    }, 5*time.Second)
+
+    result, err := future.Result()
+    if err != nil {
+        sendError(w, http.StatusInternalServerError, "Failed to get join requests")
+        return
+    }
+
+    response, ok := result.(*proto.JoinRequestList)
+    if !ok {
+        sendError(w, http.StatusInternalServerError, "Failed to get join requests")
+        return
+    }
+    if !response.Success {
+        sendError(w, http.StatusForbidden, response.Message)
+        return
+    }
+
+    sendResponse(w, http.StatusOK, Response{
+        Success: true,
+        Message: response.Message,
+        Data:    response,
+    })
+}
+
+func (s *Server) approveJoinRequest(w http.ResponseWriter, r *http.Request) {
+    s.reviewJoinRequest(w, r, true)
+}
+
+func (s *Server) declineJoinRequest(w http.ResponseWriter, r *http.Request) {
+    s.reviewJoinRequest(w, r, false)
+}
+
+func (s *Server) reviewJoinRequest(w http.ResponseWriter, r *http.Request, approve bool) {
+    vars := mux.Vars(r)
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    s.moderate(w, &proto.ReviewJoinRequest{
+        UserHandle: username,
+        Subreddit:  vars["forumName"],
+        Target:     vars["username"],
+        Approve:    approve,
+    }, "Failed to review join request")
+}
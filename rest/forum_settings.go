@@ -0,0 +1,53 @@
+// rest/forum_settings.go
+package rest
+
+import (
+    "encoding/json"
+    "net/http"
+    "github.com/gorilla/mux"
+    "reddit/proto"
+)
+
+// ForumSettingsRequest carries the settings a forum is created with, or
+// replaces them entirely when sent on its own
+type ForumSettingsRequest struct {
+    Description        string   `json:"description"`
+    Rules              []string `json:"rules"`
+    Visibility         string   `json:"visibility"`
+    TextOnly           bool     `json:"textOnly"`
+    LinksDisabled      bool     `json:"linksDisabled"`
+    MinKarma           int32    `json:"minKarma"`
+    ExcludeFromPopular bool     `json:"excludeFromPopular"`
+}
+
+func (req ForumSettingsRequest) settings() *proto.ForumSettings {
+    return &proto.ForumSettings{
+        Description:        req.Description,
+        Rules:              req.Rules,
+        Visibility:         req.Visibility,
+        TextOnly:           req.TextOnly,
+        LinksDisabled:      req.LinksDisabled,
+        MinKarma:           req.MinKarma,
+        ExcludeFromPopular: req.ExcludeFromPopular,
+    }
+}
+
+func (s *Server) updateForumSettings(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    var req ForumSettingsRequest
+    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+        sendError(w, http.StatusBadRequest, "Invalid request body")
+        return
+    }
+
+    s.moderate(w, &proto.UpdateForumSettings{
+        UserHandle: username,
+        Subreddit:  vars["forumName"],
+        Settings:   req.settings(),
+    }, "Failed to update forum settings")
+}
@@ -0,0 +1,113 @@
+// rest/listings.go
+package rest
+
+import (
+    "net/http"
+    "time"
+    "github.com/gorilla/mux"
+    "reddit/proto"
+)
+
+// getFrontPage lists posts from across the site for /api/r/all and
+// /api/r/popular, hottest first unless ?sort= says otherwise
+func (s *Server) getFrontPage(w http.ResponseWriter, r *http.Request) {
+    query := r.URL.Query()
+
+    page, ok := pageParams(w, r)
+    if !ok {
+        return
+    }
+
+    sortMethod := query.Get("sort")
+    if sortMethod == "" {
+        sortMethod = "hot"
+    }
+
+    future := s.system.Root.RequestFuture(s.engine, &proto.GetFrontPage{
+        Page:       mux.Vars(r)["page"],
+        SortMethod: sortMethod,
+        Period:     query.Get("t"),
+        After:      page.After,
+        Before:     page.Before,
+        Limit:      page.Limit,
+    }, 5*time.Second)
+
+    result, err := future.Result()
+    if err != nil {
+        sendError(w, http.StatusInternalServerError, "Failed to get posts")
+        return
+    }
+
+    response, ok := result.(*proto.FeedBundle)
+    if !ok {
+        sendError(w, http.StatusInternalServerError, "Failed to get posts")
+        return
+    }
+    if !response.Success {
+        sendError(w, http.StatusBadRequest, response.Message)
+        return
+    }
+
+    sendResponse(w, http.StatusOK, Response{
+        Success:    true,
+        Message:    response.Message,
+        Data:       response.Contents,
+        NextCursor: response.NextCursor,
+        PrevCursor: response.PrevCursor,
+    })
+}
+
+// getForumPosts lists a forum's posts without the rest of its details,
+// hottest first unless ?sort= says otherwise
+func (s *Server) getForumPosts(w http.ResponseWriter, r *http.Request) {
+    query := r.URL.Query()
+    viewer, _ := currentUser(r)
+
+    page, ok := pageParams(w, r)
+    if !ok {
+        return
+    }
+
+    sortMethod := query.Get("sort")
+    if sortMethod == "" {
+        sortMethod = "hot"
+    }
+
+    future := s.system.Root.RequestFuture(s.engine, &proto.GetForumDetails{
+        ForumName:  mux.Vars(r)["forumName"],
+        SortMethod: sortMethod,
+        Period:     query.Get("t"),
+        Viewer:     viewer,
+        After:      page.After,
+        Before:     page.Before,
+        Limit:      page.Limit,
+    }, 5*time.Second)
+
+    result, err := future.Result()
+    if err != nil {
+        sendError(w, http.StatusInternalServerError, "Failed to get posts")
+        return
+    }
+
+    response, ok := result.(*proto.ForumDetails)
+    if !ok {
+        sendError(w, http.StatusInternalServerError, "Failed to get posts")
+        return
+    }
+    if !response.Success {
+        sendError(w, http.StatusBadRequest, response.Message)
+        return
+    }
+    if response.ContentsHidden {
+        sendError(w, http.StatusForbidden, response.Message)
+        return
+    }
+
+    sendResponse(w, http.StatusOK, Response{
+        Success:    true,
+        Message:    response.Message,
+        Data:       response.Contents,
+        NextCursor: response.NextCursor,
+        PrevCursor: response.PrevCursor,
+    })
+}
@@ -0,0 +1,133 @@
+// rest/media.go
+package rest
+
+import (
+    "errors"
+    "net/http"
+    "strings"
+    "time"
+    "github.com/gorilla/mux"
+    "reddit/engine"
+    "reddit/proto"
+    "reddit/storage"
+)
+
+const (
+    // formMemory is how much of a multipart form is held in memory before
+    // the rest spills to temporary files
+    formMemory = 1 << 20
+    // formOverhead allows for the text fields sent along with a file
+    formOverhead = 1 << 20
+)
+
+func isMultipart(r *http.Request) bool {
+    return strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data")
+}
+
+// readPostForm reads a post submitted as multipart/form-data, storing the
+// uploaded media file if there is one. A post with a file is a media post
+// unless the form says otherwise.
+func (s *Server) readPostForm(w http.ResponseWriter, r *http.Request) (CreatePostRequest, *proto.Media, bool) {
+    r.Body = http.MaxBytesReader(w, r.Body, s.media.MaxSize()+formOverhead)
+    if err := r.ParseMultipartForm(formMemory); err != nil {
+        var tooLarge *http.MaxBytesError
+        if errors.As(err, &tooLarge) {
+            sendError(w, http.StatusRequestEntityTooLarge, "File is too large")
+        } else {
+            sendError(w, http.StatusBadRequest, "Invalid form")
+        }
+        return CreatePostRequest{}, nil, false
+    }
+    defer r.MultipartForm.RemoveAll()
+
+    req := CreatePostRequest{
+        Subreddit:  r.FormValue("subreddit"),
+        Title:      r.FormValue("title"),
+        Content:    r.FormValue("content"),
+        Kind:       r.FormValue("kind"),
+        Url:        r.FormValue("url"),
+        IsRepost:   r.FormValue("isRepost") == "true",
+        OriginalId: r.FormValue("originalId"),
+    }
+
+    file, _, err := r.FormFile("media")
+    if errors.Is(err, http.ErrMissingFile) {
+        return req, nil, true
+    }
+    if err != nil {
+        sendError(w, http.StatusBadRequest, "Invalid file upload")
+        return CreatePostRequest{}, nil, false
+    }
+    defer file.Close()
+
+    blob, err := s.media.Put(file)
+    switch {
+    case errors.Is(err, storage.ErrBlobTooLarge):
+        sendError(w, http.StatusRequestEntityTooLarge, "File is too large")
+        return CreatePostRequest{}, nil, false
+    case errors.Is(err, storage.ErrUnsupportedType):
+        sendError(w, http.StatusUnsupportedMediaType, "Unsupported file type")
+        return CreatePostRequest{}, nil, false
+    case err != nil:
+        sendError(w, http.StatusInternalServerError, "Failed to store file")
+        return CreatePostRequest{}, nil, false
+    }
+
+    if req.Kind == "" {
+        req.Kind = engine.PostMedia
+    }
+    return req, &proto.Media{
+        Hash:     blob.Hash,
+        MimeType: blob.MimeType,
+        Size:     blob.Size,
+    }, true
+}
+
+// serveMedia serves an uploaded file to those who can read a post that
+// carries it. Files never change once stored, so they may be cached
+// indefinitely, but only privately: whether a file may be seen depends on
+// the viewer. Images cannot send headers, so the token may also be passed as
+// ?token=.
+func (s *Server) serveMedia(w http.ResponseWriter, r *http.Request) {
+    hash := mux.Vars(r)["hash"]
+    viewer, _ := s.queryUser(r)
+
+    future := s.system.Root.RequestFuture(s.engine, &proto.GetMedia{
+        Hash:   hash,
+        Viewer: viewer,
+    }, 5*time.Second)
+
+    result, err := future.Result()
+    if err != nil {
+        sendError(w, http.StatusInternalServerError, "Failed to open media")
+        return
+    }
+
+    response, ok := result.(*proto.MediaAccess)
+    if !ok || !response.Success {
+        sendError(w, http.StatusNotFound, "Media not found")
+        return
+    }
+
+    file, err := s.media.Open(hash)
+    if errors.Is(err, storage.ErrBlobNotFound) {
+        sendError(w, http.StatusNotFound, "Media not found")
+        return
+    }
+    if err != nil {
+        sendError(w, http.StatusInternalServerError, "Failed to open media")
+        return
+    }
+    defer file.Close()
+
+    info, err := file.Stat()
+    if err != nil {
+        sendError(w, http.StatusInternalServerError, "Failed to open media")
+        return
+    }
+
+    w.Header().Set("ETag", `"`+hash+`"`)
+    w.Header().Set("Cache-Control", "private, max-age=31536000, immutable")
+    w.Header().Set("X-Content-Type-Options", "nosniff")
+    http.ServeContent(w, r, "", info.ModTime(), file)
+}
@@ -0,0 +1,184 @@
+// rest/moderation.go
+package rest
+
+import (
+    "encoding/json"
+    "net/http"
+    "time"
+    "github.com/gorilla/mux"
+    "reddit/proto"
+)
+
+type ModeratorRequest struct {
+    Username string `json:"username"`
+}
+
+type BanRequest struct {
+    Username string `json:"username"`
+    Reason   string `json:"reason"`
+}
+
+type RemoveRequest struct {
+    Reason string `json:"reason"`
+}
+
+// moderate sends a moderation command to the engine and writes the outcome
+func (s *Server) moderate(w http.ResponseWriter, msg interface{}, failure string) {
+    future := s.system.Root.RequestFuture(s.engine, msg, 5*time.Second)
+
+    result, err := future.Result()
+    if err != nil {
+        sendError(w, http.StatusInternalServerError, failure)
+        return
+    }
+
+    response, ok := result.(*proto.ModerationResponse)
+    if !ok {
+        sendError(w, http.StatusInternalServerError, failure)
+        return
+    }
+    if !response.Success {
+        sendError(w, http.StatusForbidden, response.Message)
+        return
+    }
+
+    sendResponse(w, http.StatusOK, Response{
+        Success: true,
+        Message: response.Message,
+    })
+}
+
+func (s *Server) appointModerator(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    var req ModeratorRequest
+    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+        sendError(w, http.StatusBadRequest, "Invalid request body")
+        return
+    }
+
+    s.moderate(w, &proto.AppointModerator{
+        UserHandle: username,
+        Subreddit:  vars["forumName"],
+        Target:     req.Username,
+    }, "Failed to appoint moderator")
+}
+
+func (s *Server) removeModerator(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    s.moderate(w, &proto.RemoveModerator{
+        UserHandle: username,
+        Subreddit:  vars["forumName"],
+        Target:     vars["username"],
+    }, "Failed to remove moderator")
+}
+
+func (s *Server) banUser(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    var req BanRequest
+    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+        sendError(w, http.StatusBadRequest, "Invalid request body")
+        return
+    }
+
+    s.moderate(w, &proto.BanUser{
+        UserHandle: username,
+        Subreddit:  vars["forumName"],
+        Target:     req.Username,
+        Reason:     req.Reason,
+    }, "Failed to ban user")
+}
+
+func (s *Server) unbanUser(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    s.moderate(w, &proto.UnbanUser{
+        UserHandle: username,
+        Subreddit:  vars["forumName"],
+        Target:     vars["username"],
+    }, "Failed to unban user")
+}
+
+func (s *Server) removePost(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    var req RemoveRequest
+    if r.ContentLength != 0 {
+        if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+            sendError(w, http.StatusBadRequest, "Invalid request body")
+            return
+        }
+    }
+
+    s.moderate(w, &proto.RemoveContent{
+        UserHandle: username,
+        ContentId:  vars["postId"],
+        Reason:     req.Reason,
+    }, "Failed to remove post")
+}
+
+func (s *Server) removeComment(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    var req RemoveRequest
+    if r.ContentLength != 0 {
+        if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+            sendError(w, http.StatusBadRequest, "Invalid request body")
+            return
+        }
+    }
+
+    s.moderate(w, &proto.RemoveFeedback{
+        UserHandle: username,
+        FeedbackId: vars["commentId"],
+        Reason:     req.Reason,
+    }, "Failed to remove comment")
+}
+
+func (s *Server) lockPost(w http.ResponseWriter, r *http.Request) {
+    s.setPostLock(w, r, true)
+}
+
+func (s *Server) unlockPost(w http.ResponseWriter, r *http.Request) {
+    s.setPostLock(w, r, false)
+}
+
+func (s *Server) setPostLock(w http.ResponseWriter, r *http.Request, locked bool) {
+    vars := mux.Vars(r)
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    s.moderate(w, &proto.LockContent{
+        UserHandle: username,
+        ContentId:  vars["postId"],
+        Locked:     locked,
+    }, "Failed to update lock")
+}
@@ -0,0 +1,109 @@
+// rest/notifications.go
+package rest
+
+import (
+    "encoding/json"
+    "net/http"
+    "time"
+    "reddit/proto"
+)
+
+// MarkNotificationsReadRequest names the notifications to mark as read;
+// without any, all of them are marked
+type MarkNotificationsReadRequest struct {
+    Ids []string `json:"ids"`
+}
+
+// getNotifications lists the caller's notifications, newest first. Pass
+// ?unread=true to leave out the ones already read.
+func (s *Server) getNotifications(w http.ResponseWriter, r *http.Request) {
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    page, ok := pageParams(w, r)
+    if !ok {
+        return
+    }
+
+    future := s.system.Root.RequestFuture(s.engine, &proto.GetNotifications{
+        UserHandle: username,
+        UnreadOnly: r.URL.Query().Get("unread") == "true",
+        After:      page.After,
+        Before:     page.Before,
+        Limit:      page.Limit,
+    }, 5*time.Second)
+
+    result, err := future.Result()
+    if err != nil {
+        sendError(w, http.StatusInternalServerError, "Failed to get notifications")
+        return
+    }
+
+    response, ok := result.(*proto.NotificationList)
+    if !ok {
+        sendError(w, http.StatusInternalServerError, "Failed to get notifications")
+        return
+    }
+    if !response.Success {
+        sendError(w, http.StatusBadRequest, response.Message)
+        return
+    }
+
+    sendResponse(w, http.StatusOK, Response{
+        Success: true,
+        Message: response.Message,
+        Data: map[string]interface{}{
+            "notifications": response.Notifications,
+            "unread":        response.Unread,
+        },
+        NextCursor: response.NextCursor,
+        PrevCursor: response.PrevCursor,
+    })
+}
+
+func (s *Server) markNotificationsRead(w http.ResponseWriter, r *http.Request) {
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    // The body is optional; without one every notification is marked
+    var req MarkNotificationsReadRequest
+    if r.ContentLength > 0 {
+        if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+            sendError(w, http.StatusBadRequest, "Invalid request body")
+            return
+        }
+    }
+
+    future := s.system.Root.RequestFuture(s.engine, &proto.MarkNotificationsRead{
+        UserHandle:      username,
+        NotificationIds: req.Ids,
+    }, 5*time.Second)
+
+    result, err := future.Result()
+    if err != nil {
+        sendError(w, http.StatusInternalServerError, "Failed to mark notifications as read")
+        return
+    }
+
+    response, ok := result.(*proto.MarkReadResponse)
+    if !ok {
+        sendError(w, http.StatusInternalServerError, "Failed to mark notifications as read")
+        return
+    }
+    if !response.Success {
+        sendError(w, http.StatusBadRequest, response.Message)
+        return
+    }
+
+    sendResponse(w, http.StatusOK, Response{
+        Success: true,
+        Message: response.Message,
+        Data: map[string]int32{
+            "marked": response.Marked,
+        },
+    })
+}
@@ -0,0 +1,33 @@
+// rest/pagination.go
+package rest
+
+import (
+    "net/http"
+    "strconv"
+)
+
+// PageParams are the cursor query parameters accepted by listing endpoints
+type PageParams struct {
+    After  string
+    Before string
+    Limit  int32
+}
+
+// pageParams reads ?after=, ?before= and ?limit= or sends a 400
+func pageParams(w http.ResponseWriter, r *http.Request) (PageParams, bool) {
+    query := r.URL.Query()
+    page := PageParams{
+        After:  query.Get("after"),
+        Before: query.Get("before"),
+    }
+
+    if raw := query.Get("limit"); raw != "" {
+        limit, err := strconv.ParseInt(raw, 10, 32)
+        if err != nil || limit <= 0 {
+            sendError(w, http.StatusBadRequest, "Limit must be a positive number")
+            return page, false
+        }
+        page.Limit = int32(limit)
+    }
+    return page, true
+}
@@ -0,0 +1,61 @@
+// rest/profile.go
+package rest
+
+import (
+    "net/http"
+    "time"
+    "github.com/gorilla/mux"
+    "reddit/proto"
+)
+
+type ProfileResponse struct {
+    Username     string   `json:"username"`
+    Karma        int32    `json:"karma"`
+    PostKarma    int32    `json:"postKarma"`
+    CommentKarma int32    `json:"commentKarma"`
+    Forums       []string `json:"forums"`
+    CakeDay      int64    `json:"cakeDay"`
+    IsOnline     bool     `json:"isOnline"`
+    LastSeen     int64    `json:"lastSeen"`
+}
+
+func (s *Server) getUserProfile(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    viewer, _ := currentUser(r)
+
+    future := s.system.Root.RequestFuture(s.engine, &proto.GetUserProfile{
+        UserHandle: vars["username"],
+        Viewer:     viewer,
+    }, 5*time.Second)
+
+    result, err := future.Result()
+    if err != nil {
+        sendError(w, http.StatusInternalServerError, "Failed to get user profile")
+        return
+    }
+
+    response, ok := result.(*proto.UserProfile)
+    if !ok {
+        sendError(w, http.StatusInternalServerError, "Failed to get user profile")
+        return
+    }
+    if !response.Success {
+        sendError(w, http.StatusNotFound, response.Message)
+        return
+    }
+
+    sendResponse(w, http.StatusOK, Response{
+        Success: true,
+        Message: response.Message,
+        Data: ProfileResponse{
+            Username:     response.Username,
+            Karma:        response.Karma,
+            PostKarma:    response.PostKarma,
+            CommentKarma: response.CommentKarma,
+            Forums:       response.Forums,
+            CakeDay:      response.CakeDay,
+            IsOnline:     response.IsOnline,
+            LastSeen:     response.LastSeen,
+        },
+    })
+}
@@ -0,0 +1,284 @@
+// rest/rate_limit.go
+package rest
+
+import (
+    "fmt"
+    "math"
+    "net"
+    "net/http"
+    "strconv"
+    "sync"
+    "time"
+    "github.com/gorilla/mux"
+    "reddit/proto"
+)
+
+// Actions with their own quotas
+const (
+    ActionPost    = "post"
+    ActionComment = "comment"
+    ActionVote    = "vote"
+    ActionMessage = "message"
+    ActionSignup  = "signup"
+)
+
+// limitedRoutes maps the routes that are rate limited to their action
+var limitedRoutes = map[string]string{
+    "POST /api/users":                         ActionSignup,
+    "POST /api/posts":                         ActionPost,
+    "POST /api/posts/{postId}/comments":       ActionComment,
+    "POST /api/comments/{commentId}/replies":  ActionComment,
+    "POST /api/posts/{postId}/vote":           ActionVote,
+    "DELETE /api/posts/{postId}/vote":         ActionVote,
+    "POST /api/comments/{commentId}/vote":     ActionVote,
+    "DELETE /api/comments/{commentId}/vote":   ActionVote,
+    "POST /api/messages":                      ActionMessage,
+    "POST /api/conversations/{peer}/messages": ActionMessage,
+}
+
+// How often idle buckets are dropped and how long a user's standing is
+// trusted before it is looked up again
+const (
+    sweepInterval = time.Minute
+    standingTTL   = time.Minute
+)
+
+// Quota allows Burst requests at once, refilled at PerMinute a minute. A
+// zero rate means no limit.
+type Quota struct {
+    PerMinute float64
+    Burst     int
+}
+
+// scaled returns the quota with its rate and burst multiplied by factor
+func (q Quota) scaled(factor float64) Quota {
+    return Quota{
+        PerMinute: q.PerMinute * factor,
+        Burst:     max(1, int(float64(q.Burst)*factor)),
+    }
+}
+
+// RateLimits configures how often clients may take each action. Requests
+// are counted per user and per remote IP; an IP may take IPMultiplier times
+// a single user's quota, except when signing up, which only counts IPs.
+// Accounts younger than NewcomerAge or with less than NewcomerKarma get
+// their quotas scaled by NewcomerFactor.
+type RateLimits struct {
+    Quotas         map[string]Quota
+    IPMultiplier   float64
+    NewcomerAge    time.Duration
+    NewcomerKarma  int32
+    NewcomerFactor float64
+}
+
+// Validate reports settings that would make the limits meaningless
+func (l RateLimits) Validate() error {
+    for action, quota := range l.Quotas {
+        if quota.PerMinute < 0 {
+            return fmt.Errorf("%s rate cannot be negative", action)
+        }
+    }
+    if l.IPMultiplier < 1 {
+        return fmt.Errorf("IP multiplier must be at least 1")
+    }
+    if l.NewcomerFactor <= 0 || l.NewcomerFactor > 1 {
+        return fmt.Errorf("newcomer factor must be above 0 and at most 1")
+    }
+    return nil
+}
+
+func DefaultRateLimits() RateLimits {
+    return RateLimits{
+        Quotas: map[string]Quota{
+            ActionPost:    {PerMinute: 2, Burst: 5},
+            ActionComment: {PerMinute: 10, Burst: 20},
+            ActionVote:    {PerMinute: 60, Burst: 60},
+            ActionMessage: {PerMinute: 10, Burst: 10},
+            ActionSignup:  {PerMinute: 1, Burst: 3},
+        },
+        IPMultiplier:   5,
+        NewcomerAge:    24 * time.Hour,
+        NewcomerKarma:  10,
+        NewcomerFactor: 0.25,
+    }
+}
+
+type tokenBucket struct {
+    tokens  float64
+    updated time.Time
+}
+
+// refill adds the tokens earned since the bucket was last used
+func (b *tokenBucket) refill(quota Quota, now time.Time) {
+    earned := now.Sub(b.updated).Minutes() * quota.PerMinute
+    b.tokens = math.Min(float64(quota.Burst), b.tokens+earned)
+    b.updated = now
+}
+
+type standing struct {
+    newcomer bool
+    expires  time.Time
+}
+
+// rateLimiter keeps a token bucket per action and client
+type rateLimiter struct {
+    limits   RateLimits
+    mutex    sync.Mutex
+    buckets  map[string]*tokenBucket
+    quotas   map[string]Quota
+    standing map[string]standing
+    swept    time.Time
+}
+
+func newRateLimiter(limits RateLimits) *rateLimiter {
+    return &rateLimiter{
+        limits:   limits,
+        buckets:  make(map[string]*tokenBucket),
+        quotas:   make(map[string]Quota),
+        standing: make(map[string]standing),
+        swept:    time.Now(),
+    }
+}
+
+// take spends a token from each named bucket if all of them have one. When
+// any is empty nothing is spent and take returns how long until it refills.
+// Buckets without a rate are not limited.
+func (l *rateLimiter) take(quotas map[string]Quota, now time.Time) time.Duration {
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    l.sweep(now)
+
+    var wait time.Duration
+    for key, quota := range quotas {
+        if quota.PerMinute <= 0 {
+            continue
+        }
+        bucket, exists := l.buckets[key]
+        if !exists {
+            bucket = &tokenBucket{tokens: float64(quota.Burst), updated: now}
+            l.buckets[key] = bucket
+        }
+        l.quotas[key] = quota
+        bucket.refill(quota, now)
+        if bucket.tokens < 1 {
+            missing := time.Duration((1 - bucket.tokens) / quota.PerMinute * float64(time.Minute))
+            wait = max(wait, missing)
+        }
+    }
+    if wait > 0 {
+        return wait
+    }
+    for key, quota := range quotas {
+        if quota.PerMinute > 0 {
+            l.buckets[key].tokens--
+        }
+    }
+    return 0
+}
+
+// sweep drops buckets that have refilled completely, since a fresh bucket
+// behaves the same. Callers hold the mutex.
+func (l *rateLimiter) sweep(now time.Time) {
+    if now.Sub(l.swept) < sweepInterval {
+        return
+    }
+    l.swept = now
+    for key, bucket := range l.buckets {
+        quota := l.quotas[key]
+        bucket.refill(quota, now)
+        if bucket.tokens >= float64(quota.Burst) {
+            delete(l.buckets, key)
+            delete(l.quotas, key)
+        }
+    }
+    for username, entry := range l.standing {
+        if now.After(entry.expires) {
+            delete(l.standing, username)
+        }
+    }
+}
+
+// isNewcomer reports whether a user's account is young or has little
+// karma, remembering the answer for a while
+func (s *Server) isNewcomer(username string, now time.Time) bool {
+    s.limiter.mutex.Lock()
+    entry, exists := s.limiter.standing[username]
+    s.limiter.mutex.Unlock()
+    if exists && now.Before(entry.expires) {
+        return entry.newcomer
+    }
+
+    future := s.system.Root.RequestFuture(s.engine, &proto.GetUserProfile{
+        UserHandle: username,
+    }, 5*time.Second)
+    result, err := future.Result()
+    if err != nil {
+        return false
+    }
+    profile, ok := result.(*proto.UserProfile)
+    if !ok || !profile.Success {
+        return false
+    }
+
+    limits := s.limiter.limits
+    newcomer := now.Sub(time.Unix(profile.CakeDay, 0)) < limits.NewcomerAge || profile.Karma < limits.NewcomerKarma
+    s.limiter.mutex.Lock()
+    s.limiter.standing[username] = standing{newcomer: newcomer, expires: now.Add(standingTTL)}
+    s.limiter.mutex.Unlock()
+    return newcomer
+}
+
+func remoteIP(r *http.Request) string {
+    host, _, err := net.SplitHostPort(r.RemoteAddr)
+    if err != nil {
+        return r.RemoteAddr
+    }
+    return host
+}
+
+// rateLimitMiddleware refuses limited requests from clients that have used
+// up their quota, telling them when to retry
+func (s *Server) rateLimitMiddleware(next http.Handler) http.Handler {
+    return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        route := mux.CurrentRoute(r)
+        if route == nil {
+            next.ServeHTTP(w, r)
+            return
+        }
+        template, err := route.GetPathTemplate()
+        if err != nil {
+            next.ServeHTTP(w, r)
+            return
+        }
+        action, limited := limitedRoutes[r.Method+" "+template]
+        quota := s.limiter.limits.Quotas[action]
+        if !limited || quota.PerMinute <= 0 {
+            next.ServeHTTP(w, r)
+            return
+        }
+
+        // Every request from an address is charged against the same quota,
+        // whoever makes it; newcomers only get less of their own
+        now := time.Now()
+        buckets := make(map[string]Quota)
+        if action == ActionSignup {
+            buckets[action+"/ip/"+remoteIP(r)] = quota
+        } else {
+            buckets[action+"/ip/"+remoteIP(r)] = quota.scaled(s.limiter.limits.IPMultiplier)
+            if username, ok := currentUser(r); ok {
+                if s.isNewcomer(username, now) {
+                    quota = quota.scaled(s.limiter.limits.NewcomerFactor)
+                }
+                buckets[action+"/user/"+username] = quota
+            }
+        }
+
+        if wait := s.limiter.take(buckets, now); wait > 0 {
+            w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+            sendError(w, http.StatusTooManyRequests, fmt.Sprintf("Too many requests; try again in %s", wait.Round(time.Second)))
+            return
+        }
+        next.ServeHTTP(w, r)
+    })
+}
@@ -0,0 +1,214 @@
+// rest/rate_limit_test.go
+package rest
+
+import (
+    "context"
+    "net/http"
+    "net/http/httptest"
+    "testing"
+    "time"
+    "github.com/gorilla/mux"
+)
+
+var limiterStart = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func TestTakeSpendsUntilEmpty(t *testing.T) {
+    limiter := newRateLimiter(DefaultRateLimits())
+    quota := map[string]Quota{"post/user/alice": {PerMinute: 2, Burst: 3}}
+
+    for n := 0; n < 3; n++ {
+        if wait := limiter.take(quota, limiterStart); wait != 0 {
+            t.Fatalf("request %d waited %v", n+1, wait)
+        }
+    }
+    if wait := limiter.take(quota, limiterStart); wait != 30*time.Second {
+        t.Errorf("empty bucket waits %v, want 30s", wait)
+    }
+    // Half a minute earns the next token
+    if wait := limiter.take(quota, limiterStart.Add(30*time.Second)); wait != 0 {
+        t.Errorf("refilled bucket waits %v", wait)
+    }
+}
+
+func TestTakeIsAllOrNothing(t *testing.T) {
+    limiter := newRateLimiter(DefaultRateLimits())
+    user := map[string]Quota{"vote/user/alice": {PerMinute: 1, Burst: 1}}
+    both := map[string]Quota{
+        "vote/user/alice": {PerMinute: 1, Burst: 1},
+        "vote/ip/10.0.0.1": {PerMinute: 1, Burst: 2},
+    }
+
+    limiter.take(user, limiterStart)
+    if wait := limiter.take(both, limiterStart); wait == 0 {
+        t.Fatal("took from an empty bucket")
+    }
+    // The address bucket was not charged for the refused request
+    ip := map[string]Quota{"vote/ip/10.0.0.1": {PerMinute: 1, Burst: 2}}
+    for n := 0; n < 2; n++ {
+        if wait := limiter.take(ip, limiterStart); wait != 0 {
+            t.Fatalf("address request %d waited %v", n+1, wait)
+        }
+    }
+}
+
+func TestTakeIgnoresUnlimitedQuotas(t *testing.T) {
+    limiter := newRateLimiter(DefaultRateLimits())
+    quota := map[string]Quota{"message/user/alice": {}}
+    for n := 0; n < 100; n++ {
+        if wait := limiter.take(quota, limiterStart); wait != 0 {
+            t.Fatalf("unlimited request %d waited %v", n+1, wait)
+        }
+    }
+    if len(limiter.buckets) != 0 {
+        t.Errorf("unlimited quota created %d buckets", len(limiter.buckets))
+    }
+}
+
+func TestSweepDropsFullBuckets(t *testing.T) {
+    limiter := newRateLimiter(DefaultRateLimits())
+    limiter.swept = limiterStart
+    limiter.standing["alice"] = standing{newcomer: true, expires: limiterStart.Add(standingTTL)}
+
+    limiter.take(map[string]Quota{"post/user/alice": {PerMinute: 1, Burst: 5}}, limiterStart)
+    limiter.take(map[string]Quota{"post/user/bob": {PerMinute: 1, Burst: 5}}, limiterStart.Add(50*time.Second))
+
+    // A minute on alice's bucket is full again, while bob's is not
+    limiter.take(map[string]Quota{}, limiterStart.Add(sweepInterval+time.Second))
+    if _, exists := limiter.buckets["post/user/alice"]; exists {
+        t.Error("sweep kept a full bucket")
+    }
+    if _, exists := limiter.buckets["post/user/bob"]; !exists {
+        t.Error("sweep dropped a bucket still refilling")
+    }
+    if _, exists := limiter.standing["alice"]; exists {
+        t.Error("sweep kept an expired standing")
+    }
+}
+
+func TestQuotaScaled(t *testing.T) {
+    quota := Quota{PerMinute: 2, Burst: 5}
+    if got := quota.scaled(5); got != (Quota{PerMinute: 10, Burst: 25}) {
+        t.Errorf("scaled up = %v", got)
+    }
+    // Even a heavily scaled down quota allows one request
+    if got := quota.scaled(0.1); got != (Quota{PerMinute: 0.2, Burst: 1}) {
+        t.Errorf("scaled down = %v", got)
+    }
+}
+
+func TestValidate(t *testing.T) {
+    if err := DefaultRateLimits().Validate(); err != nil {
+        t.Errorf("default limits are invalid: %v", err)
+    }
+
+    negative := DefaultRateLimits()
+    negative.Quotas = map[string]Quota{ActionPost: {PerMinute: -1, Burst: 1}}
+    lowMultiplier := DefaultRateLimits()
+    lowMultiplier.IPMultiplier = 0.5
+    noFactor := DefaultRateLimits()
+    noFactor.NewcomerFactor = 0
+    bigFactor := DefaultRateLimits()
+    bigFactor.NewcomerFactor = 1.5
+    for name, limits := range map[string]RateLimits{
+        "negative rate":      negative,
+        "low multiplier":     lowMultiplier,
+        "zero newcomer":      noFactor,
+        "oversized newcomer": bigFactor,
+    } {
+        if err := limits.Validate(); err == nil {
+            t.Errorf("%s passed validation", name)
+        }
+    }
+}
+
+// limitedServer serves the routes the tests use behind the rate limiter.
+// The X-User header stands in for a verified token.
+func limitedServer(limits RateLimits) *Server {
+    s := &Server{router: mux.NewRouter(), limiter: newRateLimiter(limits)}
+    ok := func(w http.ResponseWriter, r *http.Request) {
+        w.WriteHeader(http.StatusOK)
+    }
+    s.router.HandleFunc("/api/users", ok).Methods("POST")
+    s.router.HandleFunc("/api/posts", ok).Methods("POST")
+    s.router.HandleFunc("/api/forums/{forumName}", ok).Methods("GET")
+    s.router.Use(func(next http.Handler) http.Handler {
+        return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+            if username := r.Header.Get("X-User"); username != "" {
+                r = r.WithContext(context.WithValue(r.Context(), userContextKey, username))
+            }
+            next.ServeHTTP(w, r)
+        })
+    })
+    s.router.Use(s.rateLimitMiddleware)
+    return s
+}
+
+func serve(s *Server, method, path, address, username string) *httptest.ResponseRecorder {
+    r := httptest.NewRequest(method, path, nil)
+    r.RemoteAddr = address
+    if username != "" {
+        r.Header.Set("X-User", username)
+    }
+    w := httptest.NewRecorder()
+    s.router.ServeHTTP(w, r)
+    return w
+}
+
+// admitted counts how many of n requests get through
+func admitted(s *Server, n int, method, path, address, username string) int {
+    count := 0
+    for i := 0; i < n; i++ {
+        if serve(s, method, path, address, username).Code == http.StatusOK {
+            count++
+        }
+    }
+    return count
+}
+
+func TestMiddlewareLimitsSignupByAddress(t *testing.T) {
+    s := limitedServer(DefaultRateLimits())
+
+    if got := admitted(s, 5, "POST", "/api/users", "10.0.0.1:4000", ""); got != 3 {
+        t.Errorf("admitted %d signups, want the burst of 3", got)
+    }
+    w := serve(s, "POST", "/api/users", "10.0.0.1:4001", "")
+    if w.Code != http.StatusTooManyRequests || w.Header().Get("Retry-After") == "" {
+        t.Errorf("refused signup = %d with Retry-After %q", w.Code, w.Header().Get("Retry-After"))
+    }
+    // Signups only count addresses, so a signed in caller is no exception
+    if got := admitted(s, 1, "POST", "/api/users", "10.0.0.1:4002", "alice"); got != 0 {
+        t.Error("signup from a limited address was admitted")
+    }
+    if got := admitted(s, 1, "POST", "/api/users", "10.0.0.2:4000", ""); got != 1 {
+        t.Error("signup from another address was refused")
+    }
+}
+
+func TestMiddlewareSkipsUnlimitedRoutes(t *testing.T) {
+    s := limitedServer(DefaultRateLimits())
+    if got := admitted(s, 50, "GET", "/api/forums/golang", "10.0.0.1:4000", ""); got != 50 {
+        t.Errorf("admitted %d of 50 reads", got)
+    }
+}
+
+func TestMiddlewareLimitsUsersAndAddresses(t *testing.T) {
+    s := limitedServer(DefaultRateLimits())
+    s.limiter.standing["alice"] = standing{expires: time.Now().Add(time.Hour)}
+    s.limiter.standing["bob"] = standing{expires: time.Now().Add(time.Hour)}
+    s.limiter.standing["fresh"] = standing{newcomer: true, expires: time.Now().Add(time.Hour)}
+
+    // Each user has a burst of 5 posts, and a shared address 5 times that
+    if got := admitted(s, 8, "POST", "/api/posts", "10.0.0.1:4000", "alice"); got != 5 {
+        t.Errorf("admitted %d posts from alice, want 5", got)
+    }
+    if got := admitted(s, 8, "POST", "/api/posts", "10.0.0.1:4000", "bob"); got != 5 {
+        t.Errorf("admitted %d posts from bob, want 5", got)
+    }
+    // Newcomers get a quarter of the burst
+    if got := admitted(s, 8, "POST", "/api/posts", "10.0.0.2:4000", "fresh"); got != 1 {
+        t.Errorf("admitted %d posts from a newcomer, want 1", got)
+    }
+    if got := admitted(s, 30, "POST", "/api/posts", "10.0.0.1:4000", ""); got != 15 {
+        t.Errorf("admitted %d anonymous posts, want the rest of the address's 25", got)
+    }
+}
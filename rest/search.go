@@ -0,0 +1,55 @@
+// rest/search.go
+package rest
+
+import (
+    "net/http"
+    "time"
+    "reddit/proto"
+)
+
+func (s *Server) search(w http.ResponseWriter, r *http.Request) {
+    query := r.URL.Query()
+
+    page, ok := pageParams(w, r)
+    if !ok {
+        return
+    }
+
+    viewer, _ := currentUser(r)
+    future := s.system.Root.RequestFuture(s.engine, &proto.Search{
+        Query:      query.Get("q"),
+        Viewer:     viewer,
+        Kind:       query.Get("type"),
+        Forum:      query.Get("forum"),
+        Author:     query.Get("author"),
+        SortMethod: query.Get("sort"),
+        Period:     query.Get("t"),
+        After:      page.After,
+        Before:     page.Before,
+        Limit:      page.Limit,
+    }, 5*time.Second)
+
+    result, err := future.Result()
+    if err != nil {
+        sendError(w, http.StatusInternalServerError, "Failed to search")
+        return
+    }
+
+    response, ok := result.(*proto.SearchResults)
+    if !ok {
+        sendError(w, http.StatusInternalServerError, "Failed to search")
+        return
+    }
+    if !response.Success {
+        sendError(w, http.StatusBadRequest, response.Message)
+        return
+    }
+
+    sendResponse(w, http.StatusOK, Response{
+        Success:    true,
+        Message:    response.Message,
+        Data:       response.Results,
+        NextCursor: response.NextCursor,
+        PrevCursor: response.PrevCursor,
+    })
+}
@@ -9,61 +9,77 @@ import (
     "time"
     "github.com/gorilla/mux"
     "github.com/asynkron/protoactor-go/actor"
+    "reddit/auth"
     "reddit/proto"
+    "reddit/storage"
 )
 
 type Server struct {
-    router *mux.Router
-    engine *actor.PID
-    system *actor.ActorSystem
+    router  *mux.Router
+    engine  *actor.PID
+    system  *actor.ActorSystem
+    tokens  *auth.TokenIssuer
+    media   *storage.BlobStore
+    limiter *rateLimiter
 }
 
 type Response struct {
-    Success bool        `json:"success"`
-    Message string      `json:"message,omitempty"`
-    Data    interface{} `json:"data,omitempty"`
+    Success    bool        `json:"success"`
+    Message    string      `json:"message,omitempty"`
+    Data       interface{} `json:"data,omitempty"`
+    NextCursor string      `json:"nextCursor,omitempty"`
+    PrevCursor string      `json:"prevCursor,omitempty"`
+}
+
+// PostView is a post as GET /api/posts/{postId} returns it: without its
+// comments, which are fetched as a tree, but with how many there are
+type PostView struct {
+    *proto.Content
+    CommentCount int32 `json:"comment_count"`
 }
 
 type RegisterUserRequest struct {
     Username string `json:"username"`
+    Password string `json:"password"`
 }
 
 type CreateForumRequest struct {
-    Name        string `json:"name"`
-    Description string `json:"description"`
+    Name string `json:"name"`
+    ForumSettingsRequest
 }
 
 type CreatePostRequest struct {
-    Username    string `json:"username"`
     Subreddit   string `json:"subreddit"`
     Title       string `json:"title"`
     Content     string `json:"content"`
     IsRepost    bool   `json:"isRepost"`
     OriginalId  string `json:"originalId"`
+    Kind        string `json:"kind"`
+    Url         string `json:"url"`
 }
 
 type CreateCommentRequest struct {
-    Username string `json:"username"`
     Content  string `json:"content"`
     ParentId string `json:"parentId"`
 }
 
 type VoteRequest struct {
-    Username string `json:"username"`
-    IsUpvote bool   `json:"isUpvote"`
+    IsUpvote bool `json:"isUpvote"`
 }
 
 type SendMessageRequest struct {
-    SenderUsername   string `json:"senderUsername"`
     ReceiverUsername string `json:"receiverUsername"`
     Content          string `json:"content"`
 }
 
-func NewServer(engine *actor.PID, system *actor.ActorSystem) *Server {
+func NewServer(engine *actor.PID, system *actor.ActorSystem, tokens *auth.TokenIssuer, media *storage.BlobStore, limits RateLimits) *Server {
     s := &Server{
-        router: mux.NewRouter(),
-        engine: engine,
-        system: system,
+        router:  mux.NewRouter(),
+        engine:  engine,
+        system:  system,
+        tokens:  tokens,
+        media:   media,
+        limiter: newRateLimiter(limits),
     }
     s.setupRoutes()
     return s
@@ -72,6 +88,8 @@ func NewServer(engine *actor.PID, system *actor.ActorSystem) *Server {
 func (s *Server) setupRoutes() {
     // User routes
     s.router.HandleFunc("/api/users", s.registerUser).Methods("POST")
+    s.router.HandleFunc("/api/login", s.login).Methods("POST")
+    s.router.HandleFunc("/api/users/{username}", s.getUserProfile).Methods("GET")
     s.router.HandleFunc("/api/users/{username}/status", s.updateUserStatus).Methods("PUT")
 
     // Forum routes
@@ -79,22 +97,77 @@ func (s *Server) setupRoutes() {
     s.router.HandleFunc("/api/forums/{forumName}/join", s.joinForum).Methods("POST")
     s.router.HandleFunc("/api/forums/{forumName}/leave", s.leaveForum).Methods("POST")
     s.router.HandleFunc("/api/forums/{forumName}", s.getForumDetails).Methods("GET")
+    s.router.HandleFunc("/api/forums/{forumName}/settings", s.updateForumSettings).Methods("PUT")
+    s.router.HandleFunc("/api/forums/{forumName}/invitations", s.inviteToForum).Methods("POST")
+    s.router.HandleFunc("/api/forums/{forumName}/requests", s.requestToJoin).Methods("POST")
+    s.router.HandleFunc("/api/forums/{forumName}/requests", s.getJoinRequests).Methods("GET")
+    s.router.HandleFunc("/api/forums/{forumName}/requests/{username}/approve", s.approveJoinRequest).Methods("POST")
+    s.router.HandleFunc("/api/forums/{forumName}/requests/{username}/decline", s.declineJoinRequest).Methods("POST")
+
+    // Moderation routes
+    s.router.HandleFunc("/api/forums/{forumName}/moderators", s.appointModerator).Methods("POST")
+    s.router.HandleFunc("/api/forums/{forumName}/moderators/{username}", s.removeModerator).Methods("DELETE")
+    s.router.HandleFunc("/api/forums/{forumName}/bans", s.banUser).Methods("POST")
+    s.router.HandleFunc("/api/forums/{forumName}/bans/{username}", s.unbanUser).Methods("DELETE")
+    s.router.HandleFunc("/api/posts/{postId}/remove", s.removePost).Methods("POST")
+    s.router.HandleFunc("/api/posts/{postId}/lock", s.lockPost).Methods("POST")
+    s.router.HandleFunc("/api/posts/{postId}/unlock", s.unlockPost).Methods("POST")
+    s.router.HandleFunc("/api/comments/{commentId}/remove", s.removeComment).Methods("POST")
 
     // Post routes
     s.router.HandleFunc("/api/posts", s.createPost).Methods("POST")
     s.router.HandleFunc("/api/posts/{postId}", s.getPost).Methods("GET")
+    s.router.HandleFunc("/api/posts/{postId}", s.editPost).Methods("PUT")
+    s.router.HandleFunc("/api/posts/{postId}", s.deletePost).Methods("DELETE")
+    s.router.HandleFunc("/api/posts/{postId}/crossposts", s.getCrossposts).Methods("GET")
     s.router.HandleFunc("/api/posts/{postId}/comments", s.createComment).Methods("POST")
+    s.router.HandleFunc("/api/posts/{postId}/comments", s.getPostComments).Methods("GET")
     s.router.HandleFunc("/api/posts/{postId}/vote", s.vote).Methods("POST")
+    s.router.HandleFunc("/api/posts/{postId}/vote", s.unvote).Methods("DELETE")
+
+    // Comment routes
+    s.router.HandleFunc("/api/comments/{commentId}", s.getComment).Methods("GET")
+    s.router.HandleFunc("/api/comments/{commentId}", s.editComment).Methods("PUT")
+    s.router.HandleFunc("/api/comments/{commentId}", s.deleteComment).Methods("DELETE")
+    s.router.HandleFunc("/api/comments/{commentId}/vote", s.voteComment).Methods("POST")
+    s.router.HandleFunc("/api/comments/{commentId}/vote", s.unvoteComment).Methods("DELETE")
+    s.router.HandleFunc("/api/comments/{commentId}/replies", s.replyToComment).Methods("POST")
+    s.router.HandleFunc("/api/comments/{commentId}/replies", s.getComment).Methods("GET")
+
+    // Media routes
+    s.router.HandleFunc("/media/{hash}", s.serveMedia).Methods("GET")
 
     // Feed routes
     s.router.HandleFunc("/api/feed", s.getFeed).Methods("GET")
+    s.router.HandleFunc("/api/r/{page:all|popular}", s.getFrontPage).Methods("GET")
+    s.router.HandleFunc("/api/forums/{forumName}/posts", s.getForumPosts).Methods("GET")
+
+    // Search routes
+    s.router.HandleFunc("/api/search", s.search).Methods("GET")
+
+    // Event routes
+    s.router.HandleFunc("/api/events", s.streamEvents).Methods("GET")
 
     // Message routes
     s.router.HandleFunc("/api/messages", s.sendMessage).Methods("POST")
     s.router.HandleFunc("/api/messages/{username}", s.getMessages).Methods("GET")
+    s.router.HandleFunc("/api/conversations", s.getConversations).Methods("GET")
+    s.router.HandleFunc("/api/conversations/{peer}/messages", s.getConversation).Methods("GET")
+    s.router.HandleFunc("/api/conversations/{peer}/messages", s.replyInConversation).Methods("POST")
+    s.router.HandleFunc("/api/conversations/{peer}/read", s.markRead).Methods("POST")
+
+    // Notification routes
+    s.router.HandleFunc("/api/notifications", s.getNotifications).Methods("GET")
+    s.router.HandleFunc("/api/notifications/read", s.markNotificationsRead).Methods("POST")
+
+    // Admin routes
+    s.router.HandleFunc("/api/admin/flagged-voters", s.getFlaggedVoters).Methods("GET")
+    s.router.HandleFunc("/api/admin/flagged-voters/{username}", s.clearVoteFlag).Methods("DELETE")
 
     s.router.Use(loggingMiddleware)
     s.router.Use(corsMiddleware)
+    s.router.Use(s.authMiddleware)
+    s.router.Use(s.rateLimitMiddleware)
 }
 
 func loggingMiddleware(next http.Handler) http.Handler {
@@ -110,7 +183,7 @@ func corsMiddleware(next http.Handler) http.Handler {
     return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
         w.Header().Set("Access-Control-Allow-Origin", "*")
         w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-        w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+        w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
         
         if r.Method == "OPTIONS" {
             w.WriteHeader(http.StatusOK)
@@ -145,9 +218,22 @@ func (s *Server) registerUser(w http.ResponseWriter, r *http.Request) {
         return
     }
 
+    if req.Password == "" {
+        sendError(w, http.StatusBadRequest, "Password cannot be empty")
+        return
+    }
+
+    passwordHash, err := auth.HashPassword(req.Password)
+    if err != nil {
+        log.Printf("Failed to hash password: %v", err)
+        sendError(w, http.StatusInternalServerError, "Failed to register user")
+        return
+    }
+
     log.Printf("Processing registration for user: %s", req.Username)
     future := s.system.Root.RequestFuture(s.engine, &proto.OnboardUser{
-        UserHandle: req.Username,
+        UserHandle:   req.Username,
+        PasswordHash: passwordHash,
     }, 5*time.Second)
 
     result, err := future.Result()
@@ -172,6 +258,15 @@ func (s *Server) registerUser(w http.ResponseWriter, r *http.Request) {
 
 func (s *Server) updateUserStatus(w http.ResponseWriter, r *http.Request) {
     vars := mux.Vars(r)
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+    if vars["username"] != username {
+        sendError(w, http.StatusForbidden, "Cannot update another user's status")
+        return
+    }
+
     var req struct {
         IsOnline bool `json:"isOnline"`
     }
@@ -181,7 +276,7 @@ func (s *Server) updateUserStatus(w http.ResponseWriter, r *http.Request) {
     }
 
     future := s.system.Root.RequestFuture(s.engine, &proto.ActivityStatus{
-        UserHandle: vars["username"],
+        UserHandle: username,
         IsOnline:   req.IsOnline,
     }, 5*time.Second)
 
@@ -204,6 +299,11 @@ func (s *Server) updateUserStatus(w http.ResponseWriter, r *http.Request) {
 }
 
 func (s *Server) createForum(w http.ResponseWriter, r *http.Request) {
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
     var req CreateForumRequest
     if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
         sendError(w, http.StatusBadRequest, "Invalid request body")
@@ -211,7 +311,9 @@ func (s *Server) createForum(w http.ResponseWriter, r *http.Request) {
     }
 
     future := s.system.Root.RequestFuture(s.engine, &proto.CreateForum{
-        Name: req.Name,
+        Name:       req.Name,
+        UserHandle: username,
+        Settings:   req.settings(),
     }, 5*time.Second)
 
     result, err := future.Result()
@@ -234,14 +336,13 @@ func (s *Server) createForum(w http.ResponseWriter, r *http.Request) {
 
 func (s *Server) joinForum(w http.ResponseWriter, r *http.Request) {
     vars := mux.Vars(r)
-    var req RegisterUserRequest
-    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-        sendError(w, http.StatusBadRequest, "Invalid request body")
+    username, ok := requireUser(w, r)
+    if !ok {
         return
     }
 
     future := s.system.Root.RequestFuture(s.engine, &proto.JoinForum{
-        UserHandle: req.Username,
+        UserHandle: username,
         Subreddit:  vars["forumName"],
     }, 5*time.Second)
 
@@ -265,14 +366,13 @@ func (s *Server) joinForum(w http.ResponseWriter, r *http.Request) {
 
 func (s *Server) leaveForum(w http.ResponseWriter, r *http.Request) {
     vars := mux.Vars(r)
-    var req RegisterUserRequest
-    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-        sendError(w, http.StatusBadRequest, "Invalid request body")
+    username, ok := requireUser(w, r)
+    if !ok {
         return
     }
 
     future := s.system.Root.RequestFuture(s.engine, &proto.LeaveForum{
-        UserHandle: req.Username,
+        UserHandle: username,
         Subreddit:  vars["forumName"],
     }, 5*time.Second)
 
@@ -298,8 +398,20 @@ func (s *Server) getForumDetails(w http.ResponseWriter, r *http.Request) {
     vars := mux.Vars(r)
     forumName := vars["forumName"]
 
+    page, ok := pageParams(w, r)
+    if !ok {
+        return
+    }
+
+    viewer, _ := currentUser(r)
     future := s.system.Root.RequestFuture(s.engine, &proto.GetForumDetails{
-        ForumName: forumName,
+        ForumName:  forumName,
+        Viewer:     viewer,
+        SortMethod: r.URL.Query().Get("sort"),
+        Period:     r.URL.Query().Get("t"),
+        After:      page.After,
+        Before:     page.Before,
+        Limit:      page.Limit,
     }, 5*time.Second)
 
     result, err := future.Result()
@@ -309,32 +421,53 @@ func (s *Server) getForumDetails(w http.ResponseWriter, r *http.Request) {
     }
 
     response, ok := result.(*proto.ForumDetails)
-    if !ok || !response.Success {
+    if !ok {
+        sendError(w, http.StatusInternalServerError, "Failed to get forum details")
+        return
+    }
+    if !response.Success {
         sendError(w, http.StatusInternalServerError, response.Message)
         return
     }
 
     sendResponse(w, http.StatusOK, Response{
-        Success: true,
-        Message: response.Message,
-        Data:    response,
+        Success:    true,
+        Message:    response.Message,
+        Data:       response,
+        NextCursor: response.NextCursor,
+        PrevCursor: response.PrevCursor,
     })
 }
 
 func (s *Server) createPost(w http.ResponseWriter, r *http.Request) {
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
+    // Media posts are uploaded as forms; other posts may be sent either way
     var req CreatePostRequest
-    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+    var media *proto.Media
+    if isMultipart(r) {
+        req, media, ok = s.readPostForm(w, r)
+        if !ok {
+            return
+        }
+    } else if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
         sendError(w, http.StatusBadRequest, "Invalid request body")
         return
     }
 
     future := s.system.Root.RequestFuture(s.engine, &proto.CreateContent{
-        UserHandle:        req.Username,
+        UserHandle:        username,
         Subreddit:        req.Subreddit,
         Heading:          req.Title,
         Body:             req.Content,
         IsShare:          req.IsRepost,
         OriginalContentId: req.OriginalId,
+        Kind:             req.Kind,
+        Url:              req.Url,
+        Media:            media,
     }, 5*time.Second)
 
     result, err := future.Result()
@@ -362,8 +495,10 @@ func (s *Server) getPost(w http.ResponseWriter, r *http.Request) {
     vars := mux.Vars(r)
     postId := vars["postId"]
 
+    viewer, _ := currentUser(r)
     future := s.system.Root.RequestFuture(s.engine, &proto.GetPost{
         ContentId: postId,
+        Viewer:    viewer,
     }, 5*time.Second)
 
     result, err := future.Result()
@@ -373,20 +508,32 @@ func (s *Server) getPost(w http.ResponseWriter, r *http.Request) {
     }
 
     response, ok := result.(*proto.GetPostResponse)
-    if !ok || !response.Success {
-        sendError(w, http.StatusInternalServerError, response.Message)
+    if !ok {
+        sendError(w, http.StatusInternalServerError, "Failed to get post")
+        return
+    }
+    if !response.Success {
+        sendError(w, http.StatusNotFound, response.Message)
         return
     }
 
     sendResponse(w, http.StatusOK, Response{
         Success: true,
         Message: response.Message,
-        Data:    response.Content,
+        Data: PostView{
+            Content:      response.Content,
+            CommentCount: response.CommentCount,
+        },
     })
 }
 
 func (s *Server) createComment(w http.ResponseWriter, r *http.Request) {
     vars := mux.Vars(r)
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
     var req CreateCommentRequest
     if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
         sendError(w, http.StatusBadRequest, "Invalid request body")
@@ -394,7 +541,7 @@ func (s *Server) createComment(w http.ResponseWriter, r *http.Request) {
     }
 
     future := s.system.Root.RequestFuture(s.engine, &proto.CreateFeedback{
-        UserHandle: req.Username,
+        UserHandle: username,
         ContentId:  vars["postId"],
         ParentId:   req.ParentId,
         Body:       req.Content,
@@ -423,17 +570,35 @@ func (s *Server) createComment(w http.ResponseWriter, r *http.Request) {
 
 func (s *Server) vote(w http.ResponseWriter, r *http.Request) {
     vars := mux.Vars(r)
-    var req VoteRequest
-    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-        sendError(w, http.StatusBadRequest, "Invalid request body")
+    s.react(w, r, vars["postId"], true, false)
+}
+
+func (s *Server) unvote(w http.ResponseWriter, r *http.Request) {
+    vars := mux.Vars(r)
+    s.react(w, r, vars["postId"], true, true)
+}
+
+// react records or retracts the caller's vote on a post or comment
+func (s *Server) react(w http.ResponseWriter, r *http.Request, itemId string, isContent bool, retract bool) {
+    username, ok := requireUser(w, r)
+    if !ok {
         return
     }
 
+    var req VoteRequest
+    if !retract {
+        if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+            sendError(w, http.StatusBadRequest, "Invalid request body")
+            return
+        }
+    }
+
     future := s.system.Root.RequestFuture(s.engine, &proto.Reaction{
-        UserHandle: req.Username,
-        ItemId:     vars["postId"],
+        UserHandle: username,
+        ItemId:     itemId,
         IsPositive: req.IsUpvote,
-        IsContent:  true,
+        IsContent:  isContent,
+        Retract:    retract,
     }, 5*time.Second)
 
     result, err := future.Result()
@@ -455,16 +620,28 @@ func (s *Server) vote(w http.ResponseWriter, r *http.Request) {
 }
 
 func (s *Server) getFeed(w http.ResponseWriter, r *http.Request) {
-    username := r.URL.Query().Get("username")
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
     sortMethod := r.URL.Query().Get("sort")
     if sortMethod == "" {
         sortMethod = "hot"
     }
 
+    page, ok := pageParams(w, r)
+    if !ok {
+        return
+    }
+
     future := s.system.Root.RequestFuture(s.engine, &proto.GetFeed{
         UserHandle: username,
         SortMethod: sortMethod,
-        Limit:      50,
+        Period:     r.URL.Query().Get("t"),
+        Limit:      page.Limit,
+        After:      page.After,
+        Before:     page.Before,
     }, 5*time.Second)
 
     result, err := future.Result()
@@ -474,7 +651,11 @@ func (s *Server) getFeed(w http.ResponseWriter, r *http.Request) {
     }
 
     response, ok := result.(*proto.FeedBundle)
-    if !ok || !response.Success {
+    if !ok {
+        sendError(w, http.StatusInternalServerError, "Failed to get feed")
+        return
+    }
+    if !response.Success {
         sendError(w, http.StatusInternalServerError, response.Message)
         return
     }
@@ -487,23 +668,35 @@ func (s *Server) getFeed(w http.ResponseWriter, r *http.Request) {
     }
 
     sendResponse(w, http.StatusOK, Response{
-        Success: true,
-        Message: response.Message,
-        Data:    response.Contents,
+        Success:    true,
+        Message:    response.Message,
+        Data:       response.Contents,
+        NextCursor: response.NextCursor,
+        PrevCursor: response.PrevCursor,
     })
 }
 
 func (s *Server) sendMessage(w http.ResponseWriter, r *http.Request) {
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+
     var req SendMessageRequest
     if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
         sendError(w, http.StatusBadRequest, "Invalid request body")
         return
     }
 
+    s.deliver(w, username, req.ReceiverUsername, req.Content)
+}
+
+// deliver sends a direct message and writes the outcome
+func (s *Server) deliver(w http.ResponseWriter, sender string, receiver string, content string) {
     future := s.system.Root.RequestFuture(s.engine, &proto.DirectChat{
-        Sender:   req.SenderUsername,
-        Receiver: req.ReceiverUsername,
-        Content:  req.Content,
+        Sender:   sender,
+        Receiver: receiver,
+        Content:  content,
     }, 5*time.Second)
 
     result, err := future.Result()
@@ -526,10 +719,25 @@ func (s *Server) sendMessage(w http.ResponseWriter, r *http.Request) {
 
 func (s *Server) getMessages(w http.ResponseWriter, r *http.Request) {
     vars := mux.Vars(r)
-    username := vars["username"]
+    username, ok := requireUser(w, r)
+    if !ok {
+        return
+    }
+    if vars["username"] != username {
+        sendError(w, http.StatusForbidden, "Cannot read another user's messages")
+        return
+    }
+
+    page, ok := pageParams(w, r)
+    if !ok {
+        return
+    }
 
     future := s.system.Root.RequestFuture(s.engine, &proto.GetChats{
         UserHandle: username,
+        After:      page.After,
+        Before:     page.Before,
+        Limit:      page.Limit,
     }, 5*time.Second)
 
     result, err := future.Result()
@@ -539,15 +747,21 @@ func (s *Server) getMessages(w http.ResponseWriter, r *http.Request) {
     }
 
     response, ok := result.(*proto.ChatBundle)
-    if !ok || !response.Success {
+    if !ok {
+        sendError(w, http.StatusInternalServerError, "Failed to get messages")
+        return
+    }
+    if !response.Success {
         sendError(w, http.StatusInternalServerError, response.Message)
         return
     }
 
     sendResponse(w, http.StatusOK, Response{
-        Success: true,
-        Message: response.Message,
-        Data:    response.Messages,
+        Success:    true,
+        Message:    response.Message,
+        Data:       response.Messages,
+        NextCursor: response.NextCursor,
+        PrevCursor: response.PrevCursor,
     })
 }
 
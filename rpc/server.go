@@ -0,0 +1,257 @@
+// rpc/server.go
+package rpc
+
+import (
+    "context"
+    "fmt"
+    "log"
+    "net"
+    "time"
+    "github.com/asynkron/protoactor-go/actor"
+    "google.golang.org/grpc"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+    "reddit/proto"
+)
+
+// requestTimeout bounds how long a call waits for the engine, unless the
+// caller's deadline is sooner
+const requestTimeout = 5 * time.Second
+
+// Server implements the Reddit gRPC service by forwarding each call to the
+// social engine actor
+type Server struct {
+    proto.UnimplementedRedditServer
+    engine *actor.PID
+    system *actor.ActorSystem
+}
+
+func NewServer(engine *actor.PID, system *actor.ActorSystem) *Server {
+    return &Server{
+        engine: engine,
+        system: system,
+    }
+}
+
+// Start serves the gRPC API on addr until the listener fails
+func (s *Server) Start(addr string) error {
+    listener, err := net.Listen("tcp", addr)
+    if err != nil {
+        return fmt.Errorf("failed to listen on %s: %v", addr, err)
+    }
+
+    server := grpc.NewServer()
+    proto.RegisterRedditServer(server, s)
+    log.Printf("Starting gRPC server on %s", addr)
+    return server.Serve(listener)
+}
+
+// request sends msg to the engine and waits for a response of type T. The
+// engine's own refusals come back as responses with Success false; only
+// failing to get an answer is a gRPC error.
+func request[T any](ctx context.Context, s *Server, msg interface{}) (T, error) {
+    var zero T
+    timeout := requestTimeout
+    if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < timeout {
+        timeout = time.Until(deadline)
+    }
+
+    result, err := s.system.Root.RequestFuture(s.engine, msg, timeout).Result()
+    if err != nil {
+        return zero, status.Errorf(codes.Unavailable, "engine did not respond: %v", err)
+    }
+    if unavailable, ok := result.(*proto.EngineUnavailable); ok {
+        return zero, status.Error(codes.Unavailable, unavailable.Message)
+    }
+    response, ok := result.(T)
+    if !ok {
+        return zero, status.Errorf(codes.Internal, "unexpected response %T", result)
+    }
+    return response, nil
+}
+
+// Users
+
+func (s *Server) OnboardUser(ctx context.Context, msg *proto.OnboardUser) (*proto.OnboardUserResponse, error) {
+    return request[*proto.OnboardUserResponse](ctx, s, msg)
+}
+
+func (s *Server) UpdateActivity(ctx context.Context, msg *proto.ActivityStatus) (*proto.ActivityStatusResponse, error) {
+    return request[*proto.ActivityStatusResponse](ctx, s, msg)
+}
+
+func (s *Server) GetUserProfile(ctx context.Context, msg *proto.GetUserProfile) (*proto.UserProfile, error) {
+    return request[*proto.UserProfile](ctx, s, msg)
+}
+
+// Forums
+
+func (s *Server) CreateForum(ctx context.Context, msg *proto.CreateForum) (*proto.CreateForumResponse, error) {
+    return request[*proto.CreateForumResponse](ctx, s, msg)
+}
+
+func (s *Server) JoinForum(ctx context.Context, msg *proto.JoinForum) (*proto.JoinForumResponse, error) {
+    return request[*proto.JoinForumResponse](ctx, s, msg)
+}
+
+func (s *Server) LeaveForum(ctx context.Context, msg *proto.LeaveForum) (*proto.LeaveForumResponse, error) {
+    return request[*proto.LeaveForumResponse](ctx, s, msg)
+}
+
+func (s *Server) GetForumDetails(ctx context.Context, msg *proto.GetForumDetails) (*proto.ForumDetails, error) {
+    return request[*proto.ForumDetails](ctx, s, msg)
+}
+
+func (s *Server) UpdateForumSettings(ctx context.Context, msg *proto.UpdateForumSettings) (*proto.ModerationResponse, error) {
+    return request[*proto.ModerationResponse](ctx, s, msg)
+}
+
+func (s *Server) InviteToForum(ctx context.Context, msg *proto.InviteToForum) (*proto.ModerationResponse, error) {
+    return request[*proto.ModerationResponse](ctx, s, msg)
+}
+
+func (s *Server) RequestToJoin(ctx context.Context, msg *proto.RequestToJoin) (*proto.JoinForumResponse, error) {
+    return request[*proto.JoinForumResponse](ctx, s, msg)
+}
+
+func (s *Server) ReviewJoinRequest(ctx context.Context, msg *proto.ReviewJoinRequest) (*proto.ModerationResponse, error) {
+    return request[*proto.ModerationResponse](ctx, s, msg)
+}
+
+func (s *Server) GetJoinRequests(ctx context.Context, msg *proto.GetJoinRequests) (*proto.JoinRequestList, error) {
+    return request[*proto.JoinRequestList](ctx, s, msg)
+}
+
+// Vote manipulation
+
+func (s *Server) GetFlaggedVoters(ctx context.Context, msg *proto.GetFlaggedVoters) (*proto.FlaggedVoterList, error) {
+    return request[*proto.FlaggedVoterList](ctx, s, msg)
+}
+
+func (s *Server) ClearVoteFlag(ctx context.Context, msg *proto.ClearVoteFlag) (*proto.ModerationResponse, error) {
+    return request[*proto.ModerationResponse](ctx, s, msg)
+}
+
+// Posts and comments
+
+func (s *Server) CreateContent(ctx context.Context, msg *proto.CreateContent) (*proto.CreateContentResponse, error) {
+    return request[*proto.CreateContentResponse](ctx, s, msg)
+}
+
+func (s *Server) GetPost(ctx context.Context, msg *proto.GetPost) (*proto.GetPostResponse, error) {
+    return request[*proto.GetPostResponse](ctx, s, msg)
+}
+
+func (s *Server) GetCrossposts(ctx context.Context, msg *proto.GetCrossposts) (*proto.CrosspostList, error) {
+    return request[*proto.CrosspostList](ctx, s, msg)
+}
+
+func (s *Server) GetMedia(ctx context.Context, msg *proto.GetMedia) (*proto.MediaAccess, error) {
+    return request[*proto.MediaAccess](ctx, s, msg)
+}
+
+func (s *Server) EditContent(ctx context.Context, msg *proto.EditContent) (*proto.EditResponse, error) {
+    return request[*proto.EditResponse](ctx, s, msg)
+}
+
+func (s *Server) DeleteContent(ctx context.Context, msg *proto.DeleteContent) (*proto.EditResponse, error) {
+    return request[*proto.EditResponse](ctx, s, msg)
+}
+
+func (s *Server) CreateFeedback(ctx context.Context, msg *proto.CreateFeedback) (*proto.CreateFeedbackResponse, error) {
+    return request[*proto.CreateFeedbackResponse](ctx, s, msg)
+}
+
+func (s *Server) GetFeedback(ctx context.Context, msg *proto.GetFeedback) (*proto.GetFeedbackResponse, error) {
+    return request[*proto.GetFeedbackResponse](ctx, s, msg)
+}
+
+func (s *Server) GetCommentTree(ctx context.Context, msg *proto.GetCommentTree) (*proto.CommentTree, error) {
+    return request[*proto.CommentTree](ctx, s, msg)
+}
+
+func (s *Server) EditFeedback(ctx context.Context, msg *proto.EditFeedback) (*proto.EditResponse, error) {
+    return request[*proto.EditResponse](ctx, s, msg)
+}
+
+func (s *Server) DeleteFeedback(ctx context.Context, msg *proto.DeleteFeedback) (*proto.EditResponse, error) {
+    return request[*proto.EditResponse](ctx, s, msg)
+}
+
+func (s *Server) React(ctx context.Context, msg *proto.Reaction) (*proto.ReactionResponse, error) {
+    return request[*proto.ReactionResponse](ctx, s, msg)
+}
+
+func (s *Server) GetFeed(ctx context.Context, msg *proto.GetFeed) (*proto.FeedBundle, error) {
+    return request[*proto.FeedBundle](ctx, s, msg)
+}
+
+func (s *Server) GetFrontPage(ctx context.Context, msg *proto.GetFrontPage) (*proto.FeedBundle, error) {
+    return request[*proto.FeedBundle](ctx, s, msg)
+}
+
+func (s *Server) Search(ctx context.Context, msg *proto.Search) (*proto.SearchResults, error) {
+    return request[*proto.SearchResults](ctx, s, msg)
+}
+
+// Moderation
+
+func (s *Server) AppointModerator(ctx context.Context, msg *proto.AppointModerator) (*proto.ModerationResponse, error) {
+    return request[*proto.ModerationResponse](ctx, s, msg)
+}
+
+func (s *Server) RemoveModerator(ctx context.Context, msg *proto.RemoveModerator) (*proto.ModerationResponse, error) {
+    return request[*proto.ModerationResponse](ctx, s, msg)
+}
+
+func (s *Server) BanUser(ctx context.Context, msg *proto.BanUser) (*proto.ModerationResponse, error) {
+    return request[*proto.ModerationResponse](ctx, s, msg)
+}
+
+func (s *Server) UnbanUser(ctx context.Context, msg *proto.UnbanUser) (*proto.ModerationResponse, error) {
+    return request[*proto.ModerationResponse](ctx, s, msg)
+}
+
+func (s *Server) RemoveContent(ctx context.Context, msg *proto.RemoveContent) (*proto.ModerationResponse, error) {
+    return request[*proto.ModerationResponse](ctx, s, msg)
+}
+
+func (s *Server) RemoveFeedback(ctx context.Context, msg *proto.RemoveFeedback) (*proto.ModerationResponse, error) {
+    return request[*proto.ModerationResponse](ctx, s, msg)
+}
+
+func (s *Server) LockContent(ctx context.Context, msg *proto.LockContent) (*proto.ModerationResponse, error) {
+    return request[*proto.ModerationResponse](ctx, s, msg)
+}
+
+// Messages
+
+func (s *Server) SendMessage(ctx context.Context, msg *proto.DirectChat) (*proto.ChatResponse, error) {
+    return request[*proto.ChatResponse](ctx, s, msg)
+}
+
+func (s *Server) GetChats(ctx context.Context, msg *proto.GetChats) (*proto.ChatBundle, error) {
+    return request[*proto.ChatBundle](ctx, s, msg)
+}
+
+func (s *Server) GetConversations(ctx context.Context, msg *proto.GetConversations) (*proto.ConversationList, error) {
+    return request[*proto.ConversationList](ctx, s, msg)
+}
+
+func (s *Server) GetConversation(ctx context.Context, msg *proto.GetConversation) (*proto.ChatBundle, error) {
+    return request[*proto.ChatBundle](ctx, s, msg)
+}
+
+func (s *Server) MarkRead(ctx context.Context, msg *proto.MarkRead) (*proto.MarkReadResponse, error) {
+    return request[*proto.MarkReadResponse](ctx, s, msg)
+}
+
+// Notifications
+
+func (s *Server) GetNotifications(ctx context.Context, msg *proto.GetNotifications) (*proto.NotificationList, error) {
+    return request[*proto.NotificationList](ctx, s, msg)
+}
+
+func (s *Server) MarkNotificationsRead(ctx context.Context, msg *proto.MarkNotificationsRead) (*proto.MarkReadResponse, error) {
+    return request[*proto.MarkReadResponse](ctx, s, msg)
+}
@@ -0,0 +1,57 @@
+// rpc/watch.go
+package rpc
+
+import (
+    "log"
+    "google.golang.org/grpc"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+    "reddit/engine"
+    "reddit/proto"
+)
+
+func (s *Server) WatchFeed(msg *proto.WatchFeed, stream proto.Reddit_WatchFeedServer) error {
+    return s.watch(stream, msg.UserHandle, msg.ContentIds, engine.EventPost, engine.EventComment, engine.EventVote)
+}
+
+func (s *Server) WatchInbox(msg *proto.WatchInbox, stream proto.Reddit_WatchInboxServer) error {
+    return s.watch(stream, msg.UserHandle, nil, engine.EventMessage, engine.EventRead, engine.EventNotification)
+}
+
+// watch subscribes to the engine's events for user and sends those of the
+// given kinds until the caller goes away
+func (s *Server) watch(stream grpc.ServerStream, user string, posts []string, kinds ...string) error {
+    profile, err := request[*proto.UserProfile](stream.Context(), s, &proto.GetUserProfile{UserHandle: user})
+    if err != nil {
+        return err
+    }
+    if !profile.Success {
+        return status.Error(codes.NotFound, profile.Message)
+    }
+
+    wanted := make(map[string]bool)
+    for _, kind := range kinds {
+        wanted[kind] = true
+    }
+
+    props, events := engine.NewSubscriber(s.engine, user, posts)
+    pid := s.system.Root.Spawn(props)
+    defer s.system.Root.Stop(pid)
+
+    log.Printf("gRPC event stream opened for %s", user)
+    for {
+        select {
+        case event := <-events:
+            if !wanted[event.Kind] {
+                continue
+            }
+            if err := stream.SendMsg(event); err != nil {
+                log.Printf("gRPC event stream for %s failed: %v", user, err)
+                return err
+            }
+        case <-stream.Context().Done():
+            log.Printf("gRPC event stream closed for %s", user)
+            return nil
+        }
+    }
+}
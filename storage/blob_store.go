@@ -0,0 +1,135 @@
+// storage/blob_store.go
+package storage
+
+import (
+    "bytes"
+    "crypto/sha256"
+    "encoding/hex"
+    "errors"
+    "fmt"
+    "io"
+    "net/http"
+    "os"
+    "path/filepath"
+)
+
+var (
+    ErrBlobTooLarge    = errors.New("file is too large")
+    ErrUnsupportedType = errors.New("unsupported file type")
+    ErrBlobNotFound    = errors.New("file not found")
+)
+
+// MIME types accepted for uploads, as sniffed from the content itself
+var allowedMediaTypes = map[string]bool{
+    "image/png":  true,
+    "image/jpeg": true,
+    "image/gif":  true,
+    "image/webp": true,
+    "video/mp4":  true,
+    "video/webm": true,
+}
+
+// Blob describes a stored file
+type Blob struct {
+    Hash     string
+    MimeType string
+    Size     int64
+}
+
+// BlobStore keeps uploaded files on disk, addressed by the SHA-256 of their
+// content. Uploading the same file twice stores it once.
+type BlobStore struct {
+    dir     string
+    maxSize int64
+}
+
+func NewBlobStore(dir string, maxSize int64) (*BlobStore, error) {
+    if err := os.MkdirAll(dir, 0755); err != nil {
+        return nil, fmt.Errorf("failed to create blob directory: %v", err)
+    }
+    return &BlobStore{dir: dir, maxSize: maxSize}, nil
+}
+
+// MaxSize returns the largest file the store accepts
+func (b *BlobStore) MaxSize() int64 {
+    return b.maxSize
+}
+
+// Put stores the content of r if it is an accepted type within the size
+// limit
+func (b *BlobStore) Put(r io.Reader) (Blob, error) {
+    data, err := io.ReadAll(io.LimitReader(r, b.maxSize+1))
+    if err != nil {
+        return Blob{}, fmt.Errorf("failed to read upload: %v", err)
+    }
+    if int64(len(data)) > b.maxSize {
+        return Blob{}, ErrBlobTooLarge
+    }
+
+    mimeType := http.DetectContentType(data)
+    if !allowedMediaTypes[mimeType] {
+        return Blob{}, ErrUnsupportedType
+    }
+
+    sum := sha256.Sum256(data)
+    blob := Blob{
+        Hash:     hex.EncodeToString(sum[:]),
+        MimeType: mimeType,
+        Size:     int64(len(data)),
+    }
+
+    path := b.path(blob.Hash)
+    if _, err := os.Stat(path); err == nil {
+        return blob, nil
+    }
+    if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+        return Blob{}, fmt.Errorf("failed to create blob directory: %v", err)
+    }
+
+    // Write to a temporary file first so a crash never leaves a partial
+    // blob under its hash
+    tmp, err := os.CreateTemp(filepath.Dir(path), "upload-*")
+    if err != nil {
+        return Blob{}, fmt.Errorf("failed to create blob: %v", err)
+    }
+    if _, err := io.Copy(tmp, bytes.NewReader(data)); err != nil {
+        tmp.Close()
+        os.Remove(tmp.Name())
+        return Blob{}, fmt.Errorf("failed to write blob: %v", err)
+    }
+    if err := tmp.Close(); err != nil {
+        os.Remove(tmp.Name())
+        return Blob{}, fmt.Errorf("failed to write blob: %v", err)
+    }
+    if err := os.Rename(tmp.Name(), path); err != nil {
+        os.Remove(tmp.Name())
+        return Blob{}, fmt.Errorf("failed to store blob: %v", err)
+    }
+    return blob, nil
+}
+
+// Open returns the stored file with the given hash
+func (b *BlobStore) Open(hash string) (*os.File, error) {
+    if !validHash(hash) {
+        return nil, ErrBlobNotFound
+    }
+    file, err := os.Open(b.path(hash))
+    if errors.Is(err, os.ErrNotExist) {
+        return nil, ErrBlobNotFound
+    }
+    return file, err
+}
+
+// path spreads blobs over subdirectories named after the first two hex
+// digits of their hash
+func (b *BlobStore) path(hash string) string {
+    return filepath.Join(b.dir, hash[:2], hash)
+}
+
+func validHash(hash string) bool {
+    if len(hash) != sha256.Size*2 {
+        return false
+    }
+    _, err := hex.DecodeString(hash)
+    return err == nil
+}
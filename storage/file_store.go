@@ -0,0 +1,196 @@
+// storage/file_store.go
+package storage
+
+import (
+    "bufio"
+    "encoding/binary"
+    "errors"
+    "fmt"
+    "hash/crc32"
+    "io"
+    "log"
+    "os"
+    "path/filepath"
+    "sync"
+    protobuf "google.golang.org/protobuf/proto"
+    "reddit/proto"
+)
+
+const (
+    snapshotFile = "snapshot.pb"
+    walFile      = "wal.log"
+)
+
+// FileStore keeps an append-only write-ahead log of StoreRecords next to the
+// most recent EngineSnapshot inside a data directory. Taking a snapshot
+// replaces the previous one atomically and truncates the log.
+type FileStore struct {
+    dir   string
+    wal   *os.File
+    mutex sync.Mutex
+}
+
+func NewFileStore(dir string) (*FileStore, error) {
+    if err := os.MkdirAll(dir, 0755); err != nil {
+        return nil, fmt.Errorf("failed to create data directory: %v", err)
+    }
+
+    wal, err := os.OpenFile(filepath.Join(dir, walFile), os.O_RDWR|os.O_CREATE|os.O_APPEND, 0644)
+    if err != nil {
+        return nil, fmt.Errorf("failed to open write-ahead log: %v", err)
+    }
+
+    return &FileStore{
+        dir: dir,
+        wal: wal,
+    }, nil
+}
+
+func (f *FileStore) Load() (*proto.EngineSnapshot, []*proto.StoreRecord, error) {
+    f.mutex.Lock()
+    defer f.mutex.Unlock()
+
+    var snapshot *proto.EngineSnapshot
+    data, err := os.ReadFile(filepath.Join(f.dir, snapshotFile))
+    switch {
+    case err == nil:
+        snapshot = &proto.EngineSnapshot{}
+        if err := protobuf.Unmarshal(data, snapshot); err != nil {
+            return nil, nil, fmt.Errorf("failed to decode snapshot: %v", err)
+        }
+    case !errors.Is(err, os.ErrNotExist):
+        return nil, nil, fmt.Errorf("failed to read snapshot: %v", err)
+    }
+
+    if _, err := f.wal.Seek(0, io.SeekStart); err != nil {
+        return nil, nil, fmt.Errorf("failed to rewind write-ahead log: %v", err)
+    }
+
+    records := make([]*proto.StoreRecord, 0)
+    valid, err := ReadFrames(f.wal, func(payload []byte) error {
+        record := &proto.StoreRecord{}
+        if err := protobuf.Unmarshal(payload, record); err != nil {
+            return err
+        }
+        records = append(records, record)
+        return nil
+    })
+    if err != nil {
+        // A torn write at the tail is expected after a crash; drop it so
+        // new records are not appended behind garbage.
+        log.Printf("Write-ahead log damaged after %d records, truncating: %v", len(records), err)
+        if err := f.wal.Truncate(valid); err != nil {
+            return nil, nil, fmt.Errorf("failed to truncate write-ahead log: %v", err)
+        }
+    }
+
+    return snapshot, records, nil
+}
+
+func (f *FileStore) Append(record *proto.StoreRecord) error {
+    f.mutex.Lock()
+    defer f.mutex.Unlock()
+
+    payload, err := protobuf.Marshal(record)
+    if err != nil {
+        return fmt.Errorf("failed to encode record: %v", err)
+    }
+    if err := WriteFrame(f.wal, payload); err != nil {
+        return fmt.Errorf("failed to append record: %v", err)
+    }
+    return f.wal.Sync()
+}
+
+func (f *FileStore) Snapshot(snapshot *proto.EngineSnapshot) error {
+    f.mutex.Lock()
+    defer f.mutex.Unlock()
+
+    data, err := protobuf.Marshal(snapshot)
+    if err != nil {
+        return fmt.Errorf("failed to encode snapshot: %v", err)
+    }
+
+    tmpPath := filepath.Join(f.dir, snapshotFile+".tmp")
+    tmp, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+    if err != nil {
+        return fmt.Errorf("failed to create snapshot: %v", err)
+    }
+    if _, err := tmp.Write(data); err != nil {
+        tmp.Close()
+        return fmt.Errorf("failed to write snapshot: %v", err)
+    }
+    if err := tmp.Sync(); err != nil {
+        tmp.Close()
+        return fmt.Errorf("failed to sync snapshot: %v", err)
+    }
+    if err := tmp.Close(); err != nil {
+        return fmt.Errorf("failed to close snapshot: %v", err)
+    }
+    if err := os.Rename(tmpPath, filepath.Join(f.dir, snapshotFile)); err != nil {
+        return fmt.Errorf("failed to install snapshot: %v", err)
+    }
+
+    // Records in the log are upserts, so replaying them on top of the new
+    // snapshot is harmless if we crash before the truncate below.
+    if err := f.wal.Truncate(0); err != nil {
+        return fmt.Errorf("failed to truncate write-ahead log: %v", err)
+    }
+    return f.wal.Sync()
+}
+
+func (f *FileStore) Close() error {
+    f.mutex.Lock()
+    defer f.mutex.Unlock()
+
+    return f.wal.Close()
+}
+
+// WriteFrame writes a length and checksum prefixed payload.
+func WriteFrame(w io.Writer, payload []byte) error {
+    header := make([]byte, binary.MaxVarintLen64+4)
+    n := binary.PutUvarint(header, uint64(len(payload)))
+    binary.LittleEndian.PutUint32(header[n:], crc32.ChecksumIEEE(payload))
+
+    if _, err := w.Write(append(header[:n+4], payload...)); err != nil {
+        return err
+    }
+    return nil
+}
+
+// ReadFrames calls fn for every intact frame in r. It returns the offset just
+// past the last good frame together with the error that stopped the scan, if
+// it was anything other than a clean end of file.
+func ReadFrames(r io.Reader, fn func(payload []byte) error) (int64, error) {
+    reader := bufio.NewReader(r)
+    var offset int64
+
+    for {
+        length, err := binary.ReadUvarint(reader)
+        if err == io.EOF {
+            return offset, nil
+        }
+        if err != nil {
+            return offset, err
+        }
+
+        checksum := make([]byte, 4)
+        if _, err := io.ReadFull(reader, checksum); err != nil {
+            return offset, err
+        }
+
+        payload := make([]byte, length)
+        if _, err := io.ReadFull(reader, payload); err != nil {
+            return offset, err
+        }
+        if crc32.ChecksumIEEE(payload) != binary.LittleEndian.Uint32(checksum) {
+            return offset, fmt.Errorf("checksum mismatch at offset %d", offset)
+        }
+
+        if err := fn(payload); err != nil {
+            return offset, err
+        }
+
+        lengthBytes := make([]byte, binary.MaxVarintLen64)
+        offset += int64(binary.PutUvarint(lengthBytes, length)) + 4 + int64(length)
+    }
+}
@@ -0,0 +1,156 @@
+// storage/journal.go
+package storage
+
+import (
+    "fmt"
+    "io"
+    "log"
+    "os"
+    "path/filepath"
+    "sync"
+    protobuf "google.golang.org/protobuf/proto"
+    "reddit/proto"
+)
+
+// Journal is an ordered, append-only record of every command the engine has
+// handled. Unlike the Store it is never compacted, so it doubles as an audit
+// trail and can be replayed into a fresh engine.
+type Journal interface {
+    Append(entry *proto.JournalEntry) error
+    ReadAll() ([]*proto.JournalEntry, error)
+    LastSequence() int64
+    Close() error
+}
+
+// NopJournal records nothing.
+type NopJournal struct{}
+
+func NewNopJournal() *NopJournal {
+    return &NopJournal{}
+}
+
+func (n *NopJournal) Append(entry *proto.JournalEntry) error {
+    return nil
+}
+
+func (n *NopJournal) ReadAll() ([]*proto.JournalEntry, error) {
+    return nil, nil
+}
+
+func (n *NopJournal) LastSequence() int64 {
+    return 0
+}
+
+func (n *NopJournal) Close() error {
+    return nil
+}
+
+// FileJournal stores journal entries as checksummed frames in a single file.
+type FileJournal struct {
+    file     *os.File
+    sequence int64
+    mutex    sync.Mutex
+}
+
+func NewFileJournal(path string) (*FileJournal, error) {
+    if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+        return nil, fmt.Errorf("failed to create journal directory: %v", err)
+    }
+
+    file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0644)
+    if err != nil {
+        return nil, fmt.Errorf("failed to open journal: %v", err)
+    }
+
+    j := &FileJournal{file: file}
+    entries, err := j.ReadAll()
+    if err != nil {
+        file.Close()
+        return nil, err
+    }
+    if len(entries) > 0 {
+        j.sequence = entries[len(entries)-1].Sequence
+    }
+    return j, nil
+}
+
+func (j *FileJournal) Append(entry *proto.JournalEntry) error {
+    j.mutex.Lock()
+    defer j.mutex.Unlock()
+
+    payload, err := protobuf.Marshal(entry)
+    if err != nil {
+        return fmt.Errorf("failed to encode journal entry: %v", err)
+    }
+    if err := WriteFrame(j.file, payload); err != nil {
+        return fmt.Errorf("failed to append journal entry: %v", err)
+    }
+    if err := j.file.Sync(); err != nil {
+        return fmt.Errorf("failed to sync journal: %v", err)
+    }
+    j.sequence = entry.Sequence
+    return nil
+}
+
+// ReadAll returns every intact entry in order. A torn entry at the end of
+// the file is discarded.
+func (j *FileJournal) ReadAll() ([]*proto.JournalEntry, error) {
+    j.mutex.Lock()
+    defer j.mutex.Unlock()
+
+    if _, err := j.file.Seek(0, io.SeekStart); err != nil {
+        return nil, fmt.Errorf("failed to rewind journal: %v", err)
+    }
+
+    entries, valid, err := readEntries(j.file)
+    if err != nil {
+        log.Printf("Journal damaged after %d entries, truncating: %v", len(entries), err)
+        if err := j.file.Truncate(valid); err != nil {
+            return nil, fmt.Errorf("failed to truncate journal: %v", err)
+        }
+    }
+    return entries, nil
+}
+
+func (j *FileJournal) LastSequence() int64 {
+    j.mutex.Lock()
+    defer j.mutex.Unlock()
+
+    return j.sequence
+}
+
+func (j *FileJournal) Close() error {
+    j.mutex.Lock()
+    defer j.mutex.Unlock()
+
+    return j.file.Close()
+}
+
+// ReadJournal loads the entries of a journal file without opening it for
+// writing, e.g. a journal captured on another machine.
+func ReadJournal(path string) ([]*proto.JournalEntry, error) {
+    file, err := os.Open(path)
+    if err != nil {
+        return nil, fmt.Errorf("failed to open journal: %v", err)
+    }
+    defer file.Close()
+
+    entries, _, err := readEntries(file)
+    if err != nil {
+        log.Printf("Journal %s damaged after %d entries: %v", path, len(entries), err)
+    }
+    return entries, nil
+}
+
+func readEntries(r io.Reader) ([]*proto.JournalEntry, int64, error) {
+    entries := make([]*proto.JournalEntry, 0)
+    valid, err := ReadFrames(r, func(payload []byte) error {
+        entry := &proto.JournalEntry{}
+        if err := protobuf.Unmarshal(payload, entry); err != nil {
+            return err
+        }
+        entries = append(entries, entry)
+        return nil
+    })
+    return entries, valid, err
+}
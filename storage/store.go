@@ -0,0 +1,41 @@
+// storage/store.go
+package storage
+
+import (
+    "reddit/proto"
+)
+
+// Store persists the state of the social engine. Every mutation is appended
+// as a StoreRecord and the full state is periodically compacted into an
+// EngineSnapshot, so Load returns the latest snapshot plus the records
+// written after it.
+type Store interface {
+    Load() (*proto.EngineSnapshot, []*proto.StoreRecord, error)
+    Append(record *proto.StoreRecord) error
+    Snapshot(snapshot *proto.EngineSnapshot) error
+    Close() error
+}
+
+// NopStore discards everything it is given. It is used when no data
+// directory is configured, in which case nothing survives a restart.
+type NopStore struct{}
+
+func NewNopStore() *NopStore {
+    return &NopStore{}
+}
+
+func (n *NopStore) Load() (*proto.EngineSnapshot, []*proto.StoreRecord, error) {
+    return nil, nil, nil
+}
+
+func (n *NopStore) Append(record *proto.StoreRecord) error {
+    return nil
+}
+
+func (n *NopStore) Snapshot(snapshot *proto.EngineSnapshot) error {
+    return nil
+}
+
+func (n *NopStore) Close() error {
+    return nil
+}
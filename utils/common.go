@@ -3,6 +3,8 @@ package utils
 
 import (
     "crypto/rand"
+    "crypto/sha256"
+    "encoding/binary"
     "encoding/hex"
     "log"
     "time"
@@ -18,6 +20,15 @@ func GenerateID(prefix string) string {
     return prefix + "_" + hex.EncodeToString(bytes)
 }
 
+// DeriveID deterministically generates the n-th ID for a random seed, so the
+// same seed always yields the same sequence of IDs
+func DeriveID(prefix string, seed []byte, n int) string {
+    counter := make([]byte, 8)
+    binary.BigEndian.PutUint64(counter, uint64(n))
+    sum := sha256.Sum256(append(append([]byte{}, seed...), counter...))
+    return prefix + "_" + hex.EncodeToString(sum[:8])
+}
+
 // TimeNow returns the current Unix timestamp
 func TimeNow() int64 {
     return time.Now().Unix()
@@ -58,4 +69,31 @@ func CalculateHotScore(ups int, downs int, timestamp int64) float64 {
     
     seconds := float64(timestamp - 1577836800) // Time since 2020-01-01
     return sign*order + seconds/45000
-}
\ No newline at end of file
+}
+
+// CalculateBestScore calculates the lower bound of the Wilson score
+// confidence interval for the share of upvotes, so items with few votes
+// are not ranked above well-established ones
+func CalculateBestScore(ups int, downs int) float64 {
+    n := float64(ups + downs)
+    if n == 0 {
+        return 0
+    }
+    const z = 1.281551565545 // 80% confidence
+    p := float64(ups) / n
+    return (p + z*z/(2*n) - z*math.Sqrt((p*(1-p)+z*z/(4*n))/n)) / (1 + z*z/n)
+}
+
+// CalculateControversyScore ranks items with many, evenly split votes
+// highest
+func CalculateControversyScore(ups int, downs int) float64 {
+    if ups <= 0 || downs <= 0 {
+        return 0
+    }
+    magnitude := float64(ups + downs)
+    balance := float64(downs) / float64(ups)
+    if ups < downs {
+        balance = float64(ups) / float64(downs)
+    }
+    return math.Pow(magnitude, balance)
+}